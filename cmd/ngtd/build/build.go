@@ -25,6 +25,7 @@ import (
 	"github.com/yahoojapan/gongt"
 	"github.com/yahoojapan/ngtd/kvs"
 	"github.com/yahoojapan/ngtd/service"
+	"github.com/yahoojapan/ngtd/wal"
 )
 
 type data struct {
@@ -65,11 +66,23 @@ func (b *builder) Run(index string, dimension, poolSize int) error {
 		return fmt.Errorf("Get gongt errors: %v", errs)
 	}
 
-	b.build()
+	// the objects a server left unsaved are replayed, and the log is emptied by the save at the end.
+	// the whole build is rerun after a crash, so the records are not fsynced.
+	service.SetWALSync(wal.SyncNone, 0)
+	if err := service.OpenWAL(service.WALPath(index)); err != nil {
+		return err
+	}
+	// a write the server left in the middle is reconciled before the index is saved.
+	if err := service.OpenJournal(service.JournalPath(index)); err != nil {
+		return err
+	}
 
-	gongt.CreateAndSaveIndex(poolSize)
+	b.build()
 
-	return nil
+	if err := service.CreateIndex(poolSize); err != nil {
+		return err
+	}
+	return service.SaveIndex()
 }
 
 func (b *builder) build() {
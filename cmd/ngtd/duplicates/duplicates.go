@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package duplicates finds near-duplicate objects in an index from the command line.
+package duplicates
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kpango/glg"
+	"github.com/yahoojapan/gongt"
+	"github.com/yahoojapan/ngtd/kvs"
+	"github.com/yahoojapan/ngtd/service"
+)
+
+// progressInterval is how often the progress is logged.
+const progressInterval = 10 * time.Second
+
+// Run finds pairs of objects within threshold in index and writes them to w as lines of id1, id2 and distance
+// separated by tabs. If clusters is set, it writes a line of the max distance followed by the IDs for each cluster instead.
+// It stops when ctx is canceled, leaving the pairs written so far.
+func Run(ctx context.Context, db kvs.KVS, index string, w io.Writer, threshold, epsilon float32, clusters bool) error {
+	gongt.SetIndexPath(index).Open()
+	defer gongt.Close()
+	if errs := gongt.GetErrors(); len(errs) > 0 {
+		return fmt.Errorf("Get gongt errors: %v", errs)
+	}
+	service.SetDB(db)
+
+	bw := bufio.NewWriter(w)
+	var pairs []service.DuplicatePair
+	last := time.Now()
+	err := service.FindDuplicates(ctx, threshold, epsilon, func(done, total int) {
+		if done == total || time.Since(last) >= progressInterval {
+			glg.Infof("%d/%d objects searched", done, total)
+			last = time.Now()
+		}
+	}, func(p service.DuplicatePair) error {
+		if clusters {
+			pairs = append(pairs, p)
+			return nil
+		}
+		_, err := fmt.Fprintf(bw, "%s\t%s\t%v\n", p.ID1, p.ID2, p.Distance)
+		return err
+	})
+	if err != nil {
+		bw.Flush()
+		return err
+	}
+
+	for _, c := range service.Clusters(pairs) {
+		fmt.Fprintf(bw, "%v", c.MaxDistance)
+		for _, id := range c.IDs {
+			fmt.Fprintf(bw, "\t%s", id)
+		}
+		fmt.Fprintln(bw)
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package fsck checks and repairs the consistency of an index and its KVS from the command line.
+package fsck
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kpango/glg"
+	"github.com/yahoojapan/gongt"
+	"github.com/yahoojapan/ngtd/kvs"
+	"github.com/yahoojapan/ngtd/service"
+)
+
+// Run checks index against db and writes each inconsistency to w as a line of the kind, ID, object and
+// the ID the object maps back to, separated by tabs. If repair is set, it replays the WAL first, fixes each of them,
+// appends "repaired" or the error to the line, and saves the index with the objects copied for shared IDs
+// indexed by poolSize threads. Otherwise index and db are left as they are, and the WAL is only counted.
+// It returns an error if an inconsistency is left.
+func Run(ctx context.Context, db kvs.KVS, index string, w io.Writer, repair bool, maxObject uint, poolSize int) error {
+	gongt.SetIndexPath(index).Open()
+	defer gongt.Close()
+	if errs := gongt.GetErrors(); len(errs) > 0 {
+		return fmt.Errorf("Get gongt errors: %v", errs)
+	}
+	service.SetDB(db)
+	if repair {
+		// the objects changed since the last save are only in the WAL
+		if err := service.OpenWAL(service.WALPath(index)); err != nil {
+			return err
+		}
+		// an interrupted write is rolled back by the journal rather than repaired.
+		if err := service.OpenJournal(service.JournalPath(index)); err != nil {
+			return err
+		}
+	} else {
+		n, err := service.CountWAL(service.WALPath(index))
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			glg.Warnf("%d unreplayed WAL records, whose objects are reported missing until they are replayed by --repair or the server", n)
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	found, failed := 0, 0
+	err := service.Fsck(ctx, maxObject, func(inc service.Inconsistency) error {
+		found++
+		fmt.Fprintf(bw, "%s\t%s\t%d\t%s", inc.Kind, inc.ID, inc.Object, inc.Owner)
+		if repair {
+			if err := service.Repair(inc); err != nil {
+				failed++
+				fmt.Fprintf(bw, "\t%v", err)
+			} else {
+				fmt.Fprint(bw, "\trepaired")
+			}
+		}
+		_, err := fmt.Fprintln(bw)
+		return err
+	})
+	if ferr := bw.Flush(); err == nil {
+		err = ferr
+	}
+	if err != nil {
+		return err
+	}
+
+	glg.Infof("%d inconsistencies found", found)
+	if found == 0 {
+		return nil
+	}
+	if !repair {
+		return fmt.Errorf("%d inconsistencies found", found)
+	}
+	if err := service.CreateIndex(poolSize); err != nil {
+		return err
+	}
+	if err := service.SaveIndex(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d inconsistencies not repaired", failed, found)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package knnexport writes the k nearest neighbors of every object in an index from the command line.
+package knnexport
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/kpango/glg"
+	"github.com/yahoojapan/gongt"
+	"github.com/yahoojapan/ngtd/kvs"
+	"github.com/yahoojapan/ngtd/service"
+)
+
+const (
+	// FormatTSV writes id<TAB>neighbor:distance,... lines.
+	FormatTSV = "tsv"
+	// FormatJSONL writes a JSON object of id and neighbors per line.
+	FormatJSONL = "jsonl"
+
+	// progressInterval is how often the progress is logged.
+	progressInterval = 10 * time.Second
+)
+
+type neighbor struct {
+	ID       string  `json:"id"`
+	Distance float32 `json:"distance"`
+}
+
+type line struct {
+	ID        string     `json:"id"`
+	Neighbors []neighbor `json:"neighbors"`
+}
+
+// Run writes the k nearest neighbors of every object in index to w in format.
+// Objects are streamed from the KVS and written as soon as they are searched, so memory stays bounded.
+// It stops when ctx is canceled, leaving the lines written so far.
+func Run(ctx context.Context, db kvs.KVS, index string, w io.Writer, k int, epsilon float32, format string) error {
+	var write func(*bufio.Writer, []byte, []service.SearchResult) error
+	switch format {
+	case FormatTSV:
+		write = writeTSV
+	case FormatJSONL:
+		write = writeJSONL
+	default:
+		return fmt.Errorf("unsupported format: %v", format)
+	}
+	if k <= 0 {
+		return fmt.Errorf("k must be greater than 0: %d", k)
+	}
+
+	gongt.SetIndexPath(index).Open()
+	defer gongt.Close()
+	if errs := gongt.GetErrors(); len(errs) > 0 {
+		return fmt.Errorf("Get gongt errors: %v", errs)
+	}
+	service.SetDB(db)
+
+	bw := bufio.NewWriter(w)
+	done := 0
+	last := time.Now()
+	err := service.KNNGraph(ctx, k, epsilon, func(id []byte, neighbors []service.SearchResult) error {
+		if err := write(bw, id, neighbors); err != nil {
+			return err
+		}
+		done++
+		if time.Since(last) >= progressInterval {
+			glg.Infof("%d objects exported", done)
+			last = time.Now()
+		}
+		return nil
+	})
+	if ferr := bw.Flush(); err == nil {
+		err = ferr
+	}
+	if err != nil {
+		return err
+	}
+	glg.Infof("%d objects exported", done)
+	return nil
+}
+
+func writeTSV(w *bufio.Writer, id []byte, neighbors []service.SearchResult) error {
+	w.Write(id)
+	w.WriteByte('\t')
+	for i, n := range neighbors {
+		if i > 0 {
+			w.WriteByte(',')
+		}
+		w.Write(n.Id)
+		w.WriteByte(':')
+		w.WriteString(strconv.FormatFloat(float64(n.Distance), 'g', -1, 32))
+	}
+	return w.WriteByte('\n')
+}
+
+func writeJSONL(w *bufio.Writer, id []byte, neighbors []service.SearchResult) error {
+	l := line{
+		ID:        string(id),
+		Neighbors: make([]neighbor, len(neighbors)),
+	}
+	for i, n := range neighbors {
+		l.Neighbors[i] = neighbor{
+			ID:       string(n.Id),
+			Distance: n.Distance,
+		}
+	}
+	return json.NewEncoder(w).Encode(l)
+}
@@ -17,16 +17,25 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"runtime"
+	"syscall"
 	"time"
 
 	"github.com/kpango/glg"
 	"github.com/yahoojapan/gongt"
 	"github.com/yahoojapan/ngtd"
 	"github.com/yahoojapan/ngtd/cmd/ngtd/build"
+	"github.com/yahoojapan/ngtd/cmd/ngtd/duplicates"
+	"github.com/yahoojapan/ngtd/cmd/ngtd/fsck"
+	"github.com/yahoojapan/ngtd/cmd/ngtd/knnexport"
+	"github.com/yahoojapan/ngtd/cmd/ngtd/snapshot"
 	"github.com/yahoojapan/ngtd/kvs"
+	"github.com/yahoojapan/ngtd/service"
+	"github.com/yahoojapan/ngtd/wal"
 	"golang.org/x/sync/errgroup"
 	cli "gopkg.in/urfave/cli.v1"
 )
@@ -102,7 +111,7 @@ func main() {
 			},
 			cli.IntSliceFlag{
 				Name:  "redis-database-index, I",
-				Usage: "list up 2 redis database indexes",
+				Usage: "list up 3 redis database indexes (kv, vk, meta)",
 			},
 			cli.IntFlag{
 				Name:  "redis-ping-timeout",
@@ -125,7 +134,11 @@ func main() {
 		case "redis":
 			indexes := c.IntSlice("redis-database-index")
 			if len(indexes) == 0 {
-				indexes = cli.IntSlice{0, 1}
+				indexes = cli.IntSlice{0, 1, 2}
+			}
+			if len(indexes) == 2 {
+				// metadata database was added after kv and vk
+				indexes = append(indexes, 2)
 			}
 			pingTimeout := c.Int("redis-ping-timeout")
 			if pingTimeout <= 0 {
@@ -135,7 +148,7 @@ func main() {
 			if pingRetryFreq <= 0 {
 				return nil, fmt.Errorf("invalid value: redis-ping-retry-freq should be greater than 0: %d", pingRetryFreq)
 			}
-			return kvs.NewRedis(c.String("redis-host"), c.String("redis-port"), c.String("redis-password"), indexes[0], indexes[1], time.Duration(pingTimeout)*time.Second, time.Duration(pingRetryFreq)*time.Second)
+			return kvs.NewRedis(c.String("redis-host"), c.String("redis-port"), c.String("redis-password"), indexes[0], indexes[1], indexes[2], time.Duration(pingTimeout)*time.Second, time.Duration(pingRetryFreq)*time.Second)
 		case "bolt":
 			return kvs.NewBoltDB(p)
 		case "golevel":
@@ -145,6 +158,18 @@ func main() {
 		}
 	}
 
+	// pathDatabase opens the KVS at p for collections and reloads.
+	pathDatabase := func(p string) (kvs.KVS, error) {
+		switch dbType {
+		case "bolt":
+			return kvs.NewBoltDB(p)
+		case "golevel":
+			return kvs.NewGoLevel(p)
+		default:
+			return nil, fmt.Errorf("unsupported database type for collections and reload: %v", dbType)
+		}
+	}
+
 	serve := func(name string, alias []string, t ngtd.ServerType) cli.Command {
 		return cli.Command{
 			Name:    name,
@@ -165,11 +190,100 @@ func main() {
 					Name:  "pprof pp",
 					Usage: "enable pprof server",
 				},
+				cli.IntFlag{
+					Name:  "search-workers",
+					Value: runtime.NumCPU(),
+					Usage: "number of multi search queries running in parallel over all requests",
+				},
+				cli.StringFlag{
+					Name:  "score-mode",
+					Value: service.ScoreModeDistance,
+					Usage: "default score mode of search results (distance, similarity or inverse)",
+				},
+				cli.StringFlag{
+					Name:  "distance-type",
+					Value: "l2",
+					Usage: "distance type of the default index (l1, l2, angle, hamming or cosine). it must match the existing index",
+				},
+				cli.BoolFlag{
+					Name:  "normalize",
+					Usage: "L2-normalize vectors of the default index on insert and on query. not supported for uint8 objects",
+				},
+				cli.IntFlag{
+					Name:  "auto-index-pending",
+					Value: 0,
+					Usage: "run CreateIndex in the background once this many inserted objects are not indexed. disabled if 0",
+				},
+				cli.DurationFlag{
+					Name:  "auto-index-interval",
+					Value: 0,
+					Usage: "run CreateIndex in the background this long after the last run if some objects are not indexed. disabled if 0",
+				},
+				cli.IntFlag{
+					Name:  "auto-index-pool",
+					Value: runtime.NumCPU(),
+					Usage: "number of CPU using background CreateIndex",
+				},
+				cli.IntFlag{
+					Name:  "auto-save-unsaved",
+					Value: 0,
+					Usage: "run SaveIndex in the background once this many objects changed since the last save. disabled if 0",
+				},
+				cli.DurationFlag{
+					Name:  "auto-save-interval",
+					Value: 0,
+					Usage: "run SaveIndex in the background this long after the last run if some objects changed. disabled if 0",
+				},
+				cli.StringFlag{
+					Name:  "wal-sync",
+					Value: "always",
+					Usage: "when inserts and removes logged to the WAL are fsynced (always, interval or none)",
+				},
+				cli.DurationFlag{
+					Name:  "wal-sync-interval",
+					Value: time.Second,
+					Usage: "fsync interval of the WAL with --wal-sync interval",
+				},
+				cli.StringFlag{
+					Name:  "collection-dir",
+					Value: "",
+					Usage: "path to named collections (for golevel, bolt). collections are disabled if empty",
+				},
+				cli.BoolFlag{
+					Name:  "reload",
+					Usage: "enable the reload API and SIGHUP to swap in another index and KVS (for golevel, bolt)",
+				},
+				cli.IntFlag{
+					Name:  "reload-warm-up",
+					Value: 100,
+					Usage: "number of IDs searched on the reloaded index before it is swapped in",
+				},
 			}),
 			Action: func(c *cli.Context) error {
 				if dimension > 0 {
 					gongt.SetDimension(dimension)
 				}
+				if err := service.SetDistanceType(c.String("distance-type")); err != nil {
+					return err
+				}
+				service.SetSearchWorkers(c.Int("search-workers"))
+				if err := service.SetDefaultScoreMode(c.String("score-mode")); err != nil {
+					return err
+				}
+				policy, err := wal.ParseSyncPolicy(c.String("wal-sync"))
+				if err != nil {
+					return err
+				}
+				service.SetWALSync(policy, c.Duration("wal-sync-interval"))
+				if c.Bool("normalize") {
+					ot, err := service.IndexObjectType(index)
+					if err != nil {
+						return err
+					}
+					if ot == "uint8" {
+						return service.ErrNormalizeUnsupported
+					}
+				}
 				db, err := database(c)
 				if err != nil {
 					return err
@@ -178,6 +292,30 @@ func main() {
 				if err != nil {
 					return err
 				}
+				service.SetNormalize(c.Bool("normalize"))
+				service.SetAutoIndex(service.AutoIndexConfig{
+					Pending:  c.Int("auto-index-pending"),
+					Interval: c.Duration("auto-index-interval"),
+					PoolSize: c.Int("auto-index-pool"),
+				})
+				service.SetAutoSave(service.AutoSaveConfig{
+					Unsaved:  c.Int("auto-save-unsaved"),
+					Interval: c.Duration("auto-save-interval"),
+				})
+				if dir := c.String("collection-dir"); dir != "" {
+					if err := n.OpenCollections(dir, pathDatabase); err != nil {
+						return err
+					}
+				}
+				if c.Bool("reload") {
+					if dbType == "redis" {
+						return fmt.Errorf("unsupported database type for reload: %v", dbType)
+					}
+					service.SetReloadWarmUp(c.Int("reload-warm-up"))
+					if err := n.EnableReload(c.String("database-path"), pathDatabase); err != nil {
+						return err
+					}
+				}
 				eg := new(errgroup.Group)
 				if c.Bool("pprof") {
 					eg.Go(func() error {
@@ -235,9 +373,215 @@ func main() {
 				return build.NewBuilder(db, r, p, c.Int("parallel-parse")).Run(index, dimension, c.Int("pool"))
 			},
 		},
+		{
+			Name:  "knn-export",
+			Usage: "write k nearest neighbors of every object in ngtd index",
+			Flags: flags([]cli.Flag{
+				cli.IntFlag{
+					Name:  "k",
+					Value: 10,
+					Usage: "number of neighbors of each object",
+				},
+				cli.Float64Flag{
+					Name:  "epsilon, e",
+					Value: gongt.DefaultEpsilon,
+					Usage: "epsilon of searches",
+				},
+				cli.StringFlag{
+					Name:  "format, f",
+					Value: knnexport.FormatTSV,
+					Usage: "output format (tsv, jsonl)",
+				},
+				cli.StringFlag{
+					Name:  "output, o",
+					Value: "",
+					Usage: "path to output. stdout if empty",
+				},
+				cli.IntFlag{
+					Name:  "search-workers",
+					Value: runtime.NumCPU(),
+					Usage: "number of searches running in parallel",
+				},
+			}),
+			Action: func(c *cli.Context) error {
+				db, err := database(c)
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+				w, err := output(c.String("output"))
+				if err != nil {
+					return err
+				}
+				defer w.Close()
+				service.SetSearchWorkers(c.Int("search-workers"))
+
+				ctx, cancel := cancelOnSignal()
+				defer cancel()
+				return knnexport.Run(ctx, db, index, w, c.Int("k"), float32(c.Float64("epsilon")), c.String("format"))
+			},
+		},
+		{
+			Name:  "duplicates",
+			Usage: "find pairs of objects within the threshold in ngtd index",
+			Flags: flags([]cli.Flag{
+				cli.Float64Flag{
+					Name:  "threshold, T",
+					Usage: "maximum distance of duplicate pairs",
+				},
+				cli.Float64Flag{
+					Name:  "epsilon, e",
+					Value: gongt.DefaultEpsilon,
+					Usage: "epsilon of range searches",
+				},
+				cli.StringFlag{
+					Name:  "output, o",
+					Value: "",
+					Usage: "path to output. stdout if empty",
+				},
+				cli.BoolFlag{
+					Name:  "clusters",
+					Usage: "write clusters of duplicates instead of pairs",
+				},
+				cli.IntFlag{
+					Name:  "search-workers",
+					Value: runtime.NumCPU(),
+					Usage: "number of range searches running in parallel",
+				},
+			}),
+			Action: func(c *cli.Context) error {
+				db, err := database(c)
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+				w, err := output(c.String("output"))
+				if err != nil {
+					return err
+				}
+				defer w.Close()
+				service.SetSearchWorkers(c.Int("search-workers"))
+
+				ctx, cancel := cancelOnSignal()
+				defer cancel()
+				return duplicates.Run(ctx, db, index, w, float32(c.Float64("threshold")), float32(c.Float64("epsilon")), c.Bool("clusters"))
+			},
+		},
+		{
+			Name:  "fsck",
+			Usage: "check the consistency of ngtd index and its KVS",
+			Flags: flags([]cli.Flag{
+				cli.BoolFlag{
+					Name:  "repair",
+					Usage: "fix inconsistencies by removing orphans and rebuilding the reverse mappings",
+				},
+				cli.UintFlag{
+					Name:  "max-object",
+					Value: 0,
+					Usage: "largest object number of NGT to check. found automatically if 0",
+				},
+				cli.StringFlag{
+					Name:  "output, o",
+					Value: "",
+					Usage: "path to output. stdout if empty",
+				},
+				cli.IntFlag{
+					Name:  "pool",
+					Value: runtime.NumCPU(),
+					Usage: "number of CPU using NGT indexing after repair",
+				},
+			}),
+			Action: func(c *cli.Context) error {
+				db, err := database(c)
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+				w, err := output(c.String("output"))
+				if err != nil {
+					return err
+				}
+				defer w.Close()
+
+				ctx, cancel := cancelOnSignal()
+				defer cancel()
+				return fsck.Run(ctx, db, index, w, c.Bool("repair"), c.Uint("max-object"), c.Int("pool"))
+			},
+		},
+		{
+			Name:  "snapshot",
+			Usage: "write a snapshot of ngtd index and its KVS as a gzipped tar",
+			Flags: flags([]cli.Flag{
+				cli.StringFlag{
+					Name:  "output, o",
+					Value: "",
+					Usage: "path to output. stdout if empty",
+				},
+			}),
+			Action: func(c *cli.Context) error {
+				db, err := database(c)
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+				w, err := output(c.String("output"))
+				if err != nil {
+					return err
+				}
+				defer w.Close()
+				return snapshot.Run(db, index, w)
+			},
+		},
+		{
+			Name:      "restore",
+			Usage:     "restore ngtd index and its KVS from a snapshot",
+			ArgsUsage: "[snapshot file, stdin if omitted]",
+			Flags:     flags(nil),
+			Action: func(c *cli.Context) error {
+				db, err := database(c)
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+				r := os.Stdin
+				if p := c.Args().Get(0); p != "" {
+					if r, err = os.Open(p); err != nil {
+						return err
+					}
+					defer r.Close()
+				}
+				return snapshot.Restore(db, index, r)
+			},
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
 		glg.Fatal(err)
 	}
 }
+
+// cancelOnSignal returns a context canceled by SIGINT or SIGTERM, so that long running commands stop cleanly.
+func cancelOnSignal() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		defer signal.Stop(sigCh)
+		select {
+		case <-sigCh:
+			glg.Info("canceling ...")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// output creates the file at p, or returns stdout with logs moved to stderr if p is empty.
+func output(p string) (*os.File, error) {
+	if p == "" {
+		glg.Get().SetMode(glg.WRITER).SetWriter(os.Stderr)
+		return os.Stdout, nil
+	}
+	return os.Create(p)
+}
@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package snapshot writes and restores snapshots of an index and its KVS from the command line.
+package snapshot
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kpango/glg"
+	"github.com/yahoojapan/gongt"
+	"github.com/yahoojapan/ngtd/kvs"
+	"github.com/yahoojapan/ngtd/service"
+)
+
+// Run saves index with the objects left in its WAL, and writes a snapshot of it and db to w.
+func Run(db kvs.KVS, index string, w io.Writer) error {
+	gongt.SetIndexPath(index).Open()
+	defer gongt.Close()
+	if errs := gongt.GetErrors(); len(errs) > 0 {
+		return fmt.Errorf("Get gongt errors: %v", errs)
+	}
+	service.SetDB(db)
+	if err := service.OpenWAL(service.WALPath(index)); err != nil {
+		return err
+	}
+	if err := service.OpenJournal(service.JournalPath(index)); err != nil {
+		return err
+	}
+
+	sn, err := service.TakeSnapshot()
+	if err != nil {
+		return err
+	}
+	defer sn.Close()
+	n, err := sn.WriteTo(w)
+	if err != nil {
+		return err
+	}
+	glg.Infof("wrote a snapshot of %d objects in %d bytes", sn.Manifest.Objects, n)
+	return nil
+}
+
+// Restore validates the snapshot read from r, and restores it to index and db, which must be empty.
+func Restore(db kvs.KVS, index string, r io.Reader) error {
+	m, err := service.RestoreSnapshot(r, index, db)
+	if err != nil {
+		return err
+	}
+	glg.Infof("restored a snapshot of %d objects taken at %v", m.Objects, m.Created)
+	return nil
+}
@@ -102,7 +102,7 @@ func main() {
 		glg.Fatalln(err)
 	}
 
-	if _, err := c.SaveIndex(context.Background(), &pb.Empty{}); err != nil {
+	if _, err := c.SaveIndex(context.Background(), &pb.CollectionRequest{}); err != nil {
 		glg.Fatalln(err)
 	}
 
@@ -0,0 +1,186 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package filter provides attribute predicates evaluated against object metadata.
+//
+// A filter is an expression such as
+//	category == "shoes" AND (price < 100 OR sale == true)
+// Fields are keys of the JSON object stored as metadata. Nested keys are joined with dots.
+// Supported operators are ==, !=, <, <=, >, >=, AND, OR and NOT (also &&, || and !).
+// A comparison with a missing field or a value of another type is false.
+package filter
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Filter is a parsed filter expression.
+type Filter struct {
+	expr string
+	root node
+}
+
+// Parse parses a filter expression.
+func Parse(expr string) (*Filter, error) {
+	p := &parser{lex: newLexer(expr)}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, p.errorf("unexpected %s", p.tok)
+	}
+	return &Filter{
+		expr: expr,
+		root: root,
+	}, nil
+}
+
+// Match reports whether metadata satisfies the filter. Metadata which is not a JSON object never matches.
+func (f *Filter) Match(meta []byte) bool {
+	if len(meta) == 0 {
+		return false
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(meta, &obj); err != nil {
+		return false
+	}
+	return f.root.eval(obj)
+}
+
+func (f *Filter) String() string {
+	return f.expr
+}
+
+type node interface {
+	eval(map[string]interface{}) bool
+}
+
+type andNode struct {
+	l, r node
+}
+
+func (n andNode) eval(obj map[string]interface{}) bool {
+	return n.l.eval(obj) && n.r.eval(obj)
+}
+
+type orNode struct {
+	l, r node
+}
+
+func (n orNode) eval(obj map[string]interface{}) bool {
+	return n.l.eval(obj) || n.r.eval(obj)
+}
+
+type notNode struct {
+	n node
+}
+
+func (n notNode) eval(obj map[string]interface{}) bool {
+	return !n.n.eval(obj)
+}
+
+type cmpNode struct {
+	field []string
+	op    string
+	value interface{}
+}
+
+func (n cmpNode) eval(obj map[string]interface{}) bool {
+	v, ok := lookup(obj, n.field)
+	if !ok {
+		return false
+	}
+	switch x := v.(type) {
+	case float64:
+		y, ok := n.value.(float64)
+		if !ok {
+			return false
+		}
+		return compare(n.op, cmpFloat(x, y))
+	case string:
+		y, ok := n.value.(string)
+		if !ok {
+			return false
+		}
+		return compare(n.op, strings.Compare(x, y))
+	case bool:
+		y, ok := n.value.(bool)
+		if !ok {
+			return false
+		}
+		return equality(n.op, x == y)
+	case nil:
+		return equality(n.op, n.value == nil)
+	}
+	return false
+}
+
+func lookup(obj map[string]interface{}, field []string) (interface{}, bool) {
+	var v interface{} = obj
+	for _, f := range field {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[f]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+func cmpFloat(x, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func compare(op string, c int) bool {
+	switch op {
+	case "==":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+func equality(op string, eq bool) bool {
+	switch op {
+	case "==":
+		return eq
+	case "!=":
+		return !eq
+	}
+	return false
+}
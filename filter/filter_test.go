@@ -0,0 +1,90 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package filter
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr  string
+		valid bool
+	}{
+		{`category == "shoes"`, true},
+		{`category == "shoes" AND price < 100`, true},
+		{`(a == 1 || b != 2) && !c == true`, true},
+		{`NOT spec.size >= -1.5e2`, true},
+		{`a == null`, true},
+		{``, false},
+		{`category`, false},
+		{`category ==`, false},
+		{`category == "shoes`, false},
+		{`(a == 1`, false},
+		{`a == 1 b == 2`, false},
+		{`a < true`, false},
+		{`a == foo`, false},
+		{`a $ 1`, false},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.expr)
+		if (err == nil) != tt.valid {
+			t.Errorf("TestParse(%v): %v, wanted valid: %v", tt.expr, err, tt.valid)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	meta := []byte(`{"category":"shoes","price":80,"sale":true,"spec":{"size":27.5},"note":null}`)
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`category == "shoes"`, true},
+		{`category != "shoes"`, false},
+		{`category == "shoes" AND price < 100`, true},
+		{`category == "shoes" AND price < 50`, false},
+		{`category == "bags" OR price <= 80`, true},
+		{`NOT (category == "bags")`, true},
+		{`!sale == true`, false},
+		{`sale == true && spec.size > 27`, true},
+		{`spec.size >= 28`, false},
+		{`category > "bags"`, true},
+		{`note == null`, true},
+		{`missing == 1`, false},
+		{`missing != 1`, false},
+		{`price == "80"`, false},
+		{`spec == 1`, false},
+	}
+	for _, tt := range tests {
+		f, err := Parse(tt.expr)
+		if err != nil {
+			t.Errorf("Unexpected error: TestMatch(%v)", err)
+			continue
+		}
+		if got := f.Match(meta); got != tt.want {
+			t.Errorf("TestMatch(%v): %v, wanted: %v", tt.expr, got, tt.want)
+		}
+	}
+
+	f, _ := Parse(`price < 100`)
+	for _, meta := range [][]byte{nil, []byte("shoes"), []byte(`[1, 2]`)} {
+		if f.Match(meta) {
+			t.Errorf("TestMatch(%s): true, wanted: false", meta)
+		}
+	}
+}
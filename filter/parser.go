@@ -0,0 +1,266 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokOp
+	tokAnd
+	tokOr
+	tokNot
+	tokLParen
+	tokRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of filter"
+	}
+	return strconv.Quote(t.text)
+}
+
+type lexer struct {
+	src []rune
+	pos int
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{src: []rune(src)}
+}
+
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.src) && unicode.IsSpace(l.src[l.pos]) {
+		l.pos++
+	}
+	if l.pos >= len(l.src) {
+		return token{kind: tokEOF, pos: l.pos}, nil
+	}
+
+	start := l.pos
+	c := l.src[l.pos]
+	switch {
+	case c == '(':
+		l.pos++
+		return token{tokLParen, "(", start}, nil
+	case c == ')':
+		l.pos++
+		return token{tokRParen, ")", start}, nil
+	case c == '"':
+		return l.string()
+	case c == '-' || c == '.' || unicode.IsDigit(c):
+		for l.pos < len(l.src) && strings.ContainsRune("+-.eE0123456789", l.src[l.pos]) {
+			l.pos++
+		}
+		return token{tokNumber, string(l.src[start:l.pos]), start}, nil
+	case c == '_' || unicode.IsLetter(c):
+		for l.pos < len(l.src) && (l.src[l.pos] == '_' || l.src[l.pos] == '.' || unicode.IsLetter(l.src[l.pos]) || unicode.IsDigit(l.src[l.pos])) {
+			l.pos++
+		}
+		text := string(l.src[start:l.pos])
+		switch strings.ToUpper(text) {
+		case "AND":
+			return token{tokAnd, text, start}, nil
+		case "OR":
+			return token{tokOr, text, start}, nil
+		case "NOT":
+			return token{tokNot, text, start}, nil
+		}
+		return token{tokIdent, text, start}, nil
+	}
+
+	for _, op := range []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!"} {
+		if strings.HasPrefix(string(l.src[l.pos:]), op) {
+			l.pos += len(op)
+			switch op {
+			case "&&":
+				return token{tokAnd, op, start}, nil
+			case "||":
+				return token{tokOr, op, start}, nil
+			case "!":
+				return token{tokNot, op, start}, nil
+			}
+			return token{tokOp, op, start}, nil
+		}
+	}
+	return token{}, fmt.Errorf("filter: unexpected character %q at %d", c, start)
+}
+
+func (l *lexer) string() (token, error) {
+	start := l.pos
+	l.pos++
+	for l.pos < len(l.src) {
+		switch l.src[l.pos] {
+		case '\\':
+			l.pos += 2
+			continue
+		case '"':
+			l.pos++
+			s, err := strconv.Unquote(string(l.src[start:l.pos]))
+			if err != nil {
+				return token{}, fmt.Errorf("filter: invalid string at %d: %v", start, err)
+			}
+			return token{tokString, s, start}, nil
+		}
+		l.pos++
+	}
+	return token{}, fmt.Errorf("filter: unterminated string at %d", start)
+}
+
+type parser struct {
+	lex *lexer
+	tok token
+}
+
+func (p *parser) next() (err error) {
+	p.tok, err = p.lex.next()
+	return err
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("filter: "+format+" at %d", append(args, p.tok.pos)...)
+}
+
+func (p *parser) parseOr() (node, error) {
+	l, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokOr {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		r, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l = orNode{l, r}
+	}
+	return l, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	l, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokAnd {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		r, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		l = andNode{l, r}
+	}
+	return l, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.tok.kind == tokNot {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		n, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{n}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	switch p.tok.kind {
+	case tokLParen:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokRParen {
+			return nil, p.errorf("expected ) but got %s", p.tok)
+		}
+		return n, p.next()
+	case tokIdent:
+		return p.parseComparison()
+	}
+	return nil, p.errorf("expected field or ( but got %s", p.tok)
+}
+
+func (p *parser) parseComparison() (node, error) {
+	field := strings.Split(p.tok.text, ".")
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokOp {
+		return nil, p.errorf("expected comparison operator but got %s", p.tok)
+	}
+	op := p.tok.text
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	switch p.tok.kind {
+	case tokString:
+		value = p.tok.text
+	case tokNumber:
+		f, err := strconv.ParseFloat(p.tok.text, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %s", p.tok)
+		}
+		value = f
+	case tokIdent:
+		switch p.tok.text {
+		case "true":
+			value = true
+		case "false":
+			value = false
+		case "null":
+			value = nil
+		default:
+			return nil, p.errorf("expected value but got %s", p.tok)
+		}
+		if op != "==" && op != "!=" {
+			return nil, p.errorf("operator %s is not defined for %s", op, p.tok)
+		}
+	default:
+		return nil, p.errorf("expected value but got %s", p.tok)
+	}
+	return cmpNode{field, op, value}, p.next()
+}
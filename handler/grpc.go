@@ -17,32 +17,61 @@
 package handler
 
 import (
+	"bufio"
 	"io"
+	"time"
 
-	"github.com/yahoojapan/gongt"
+	"github.com/yahoojapan/ngtd/filter"
 	pb "github.com/yahoojapan/ngtd/proto"
 	"github.com/yahoojapan/ngtd/service"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GRPC struct{}
 
+const (
+	// rangeChunkSize is the number of results in each response of range search.
+	rangeChunkSize = 1000
+	// progressInterval is how often FindDuplicates reports the progress at least.
+	progressInterval = time.Second
+	// snapshotChunkSize is the largest data in each response of Snapshot.
+	snapshotChunkSize = 1 << 20
+)
+
 func (g *GRPC) Search(ctx context.Context, in *pb.SearchRequest) (*pb.SearchResponse, error) {
-	result, err := service.Search(in.Vector, int(in.Size_), in.Epsilon)
+	p, err := toSearchOptions(in)
 	if err != nil {
 		return nil, err
 	}
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	result, err := s.SearchContext(ctx, in.Vector, int(in.Size_), in.Epsilon, p.opts...)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 
-	return toSearchResponse(result), nil
+	return toSearchResponse(result, p), nil
 }
 
 func (g *GRPC) SearchByID(ctx context.Context, in *pb.SearchRequest) (*pb.SearchResponse, error) {
-	result, err := service.SearchByID(in.Id, int(in.Size_), in.Epsilon)
+	p, err := toSearchOptions(in)
 	if err != nil {
 		return nil, err
 	}
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	result, err := s.SearchByIDContext(ctx, in.Id, int(in.Size_), in.Epsilon, p.opts...)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 
-	return toSearchResponse(result), nil
+	return toSearchResponse(result, p), nil
 }
 
 func (g *GRPC) StreamSearch(srv pb.NGTD_StreamSearchServer) error {
@@ -55,11 +84,21 @@ func (g *GRPC) StreamSearch(srv pb.NGTD_StreamSearchServer) error {
 			return err
 		}
 
-		result, err := service.Search(in.Vector, int(in.Size_), in.Epsilon)
+		p, err := toSearchOptions(in)
+		if err != nil {
+			srv.Send(&pb.SearchResponse{Error: err.Error()})
+			continue
+		}
+		s, err := service.GetCollection(in.Collection)
+		if err != nil {
+			srv.Send(&pb.SearchResponse{Error: err.Error()})
+			continue
+		}
+		result, err := s.SearchContext(srv.Context(), in.Vector, int(in.Size_), in.Epsilon, p.opts...)
 		if err != nil {
 			srv.Send(&pb.SearchResponse{Error: err.Error()})
 		} else {
-			srv.Send(toSearchResponse(result))
+			srv.Send(toSearchResponse(result, p))
 		}
 	}
 }
@@ -74,19 +113,160 @@ func (g *GRPC) StreamSearchByID(srv pb.NGTD_StreamSearchByIDServer) error {
 			return err
 		}
 
-		result, err := service.SearchByID(in.Id, int(in.Size_), in.Epsilon)
+		p, err := toSearchOptions(in)
+		if err != nil {
+			srv.Send(&pb.SearchResponse{Error: err.Error()})
+			continue
+		}
+		s, err := service.GetCollection(in.Collection)
+		if err != nil {
+			srv.Send(&pb.SearchResponse{Error: err.Error()})
+			continue
+		}
+		result, err := s.SearchByIDContext(srv.Context(), in.Id, int(in.Size_), in.Epsilon, p.opts...)
 		if err != nil {
 			srv.Send(&pb.SearchResponse{Error: err.Error()})
 		} else {
-			srv.Send(toSearchResponse(result))
+			srv.Send(toSearchResponse(result, p))
 		}
 	}
 }
 
-func (g *GRPC) Insert(ctx context.Context, in *pb.InsertRequest) (*pb.InsertResponse, error) {
-	if err := service.Insert(in.Vector, in.Id); err != nil {
+// SearchRange streams all objects within the radius from the vector, rangeChunkSize results per response.
+// The whole result is searched before the first response is sent.
+func (g *GRPC) SearchRange(in *pb.SearchRequest, srv pb.NGTD_SearchRangeServer) error {
+	p, err := toSearchOptions(in)
+	if err != nil {
+		return err
+	}
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return toCollectionError(err)
+	}
+	result, err := s.SearchRangeContext(srv.Context(), in.Vector, toRadius(in), in.Epsilon, append(p.opts, service.WithTruncated(&p.truncated))...)
+	if err != nil {
+		return toGRPCError(err)
+	}
+	return sendChunks(srv, result, p)
+}
+
+// SearchRangeByID streams all objects within the radius from the object of the ID.
+func (g *GRPC) SearchRangeByID(in *pb.SearchRequest, srv pb.NGTD_SearchRangeByIDServer) error {
+	p, err := toSearchOptions(in)
+	if err != nil {
+		return err
+	}
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return toCollectionError(err)
+	}
+	result, err := s.SearchRangeByIDContext(srv.Context(), in.Id, toRadius(in), in.Epsilon, append(p.opts, service.WithTruncated(&p.truncated))...)
+	if err != nil {
+		return toGRPCError(err)
+	}
+	return sendChunks(srv, result, p)
+}
+
+// SearchByExamples searches the objects like the positive examples and unlike the negative ones.
+func (g *GRPC) SearchByExamples(ctx context.Context, in *pb.SearchRequest) (*pb.SearchResponse, error) {
+	p, err := toSearchOptions(in)
+	if err != nil {
 		return nil, err
 	}
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	result, err := s.SearchByExamplesContext(ctx, toExamples(in.Positive), toExamples(in.Negative), int(in.Size_), in.Epsilon, p.opts...)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toSearchResponse(result, p), nil
+}
+
+// SearchByExpression searches with the weighted sum of stored objects and literal vectors.
+func (g *GRPC) SearchByExpression(ctx context.Context, in *pb.SearchRequest) (*pb.SearchResponse, error) {
+	p, err := toSearchOptions(in)
+	if err != nil {
+		return nil, err
+	}
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	result, err := s.SearchByExpressionContext(ctx, toTerms(in.Terms), int(in.Size_), in.Epsilon, p.opts...)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return toSearchResponse(result, p), nil
+}
+
+// sendChunks splits results into responses so that each message stays small.
+// It only bounds the size of each message; results is already complete, so nothing is sent while searching.
+// Stats and truncated are sent with the last response.
+func sendChunks(srv interface {
+	Send(*pb.SearchResponse) error
+}, result []service.SearchResult, p *searchParams) error {
+	chunk := *p
+	chunk.stats = nil
+	chunk.truncated = false
+	for len(result) > rangeChunkSize {
+		if err := srv.Send(toSearchResponse(result[:rangeChunkSize], &chunk)); err != nil {
+			return err
+		}
+		result = result[rangeChunkSize:]
+	}
+	return srv.Send(toSearchResponse(result, p))
+}
+
+// MultiSearch runs the requests in parallel. The collection of each request is ignored in favor of in.Collection.
+func (g *GRPC) MultiSearch(ctx context.Context, in *pb.MultiSearchRequest) (*pb.MultiSearchResponse, error) {
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+
+	ret := make([]*pb.SearchResponse, len(in.Requests))
+	queries := make([]service.SearchQuery, 0, len(in.Requests))
+	idx := make([]int, 0, len(in.Requests))
+	params := make([]*searchParams, 0, len(in.Requests))
+	for i, req := range in.Requests {
+		p, err := toSearchOptions(req)
+		if err != nil {
+			ret[i] = &pb.SearchResponse{Error: err.Error()}
+			continue
+		}
+		params = append(params, p)
+		queries = append(queries, service.SearchQuery{
+			Vector:  req.Vector,
+			ID:      req.Id,
+			Size:    int(req.Size_),
+			Epsilon: req.Epsilon,
+			Options: p.opts,
+		})
+		idx = append(idx, i)
+	}
+
+	for i, r := range s.MultiSearchContext(ctx, queries) {
+		if r.Error != nil {
+			ret[idx[i]] = &pb.SearchResponse{Error: r.Error.Error()}
+		} else {
+			ret[idx[i]] = toSearchResponse(r.Result, params[i])
+		}
+	}
+	return &pb.MultiSearchResponse{Responses: ret}, nil
+}
+
+func (g *GRPC) Insert(ctx context.Context, in *pb.InsertRequest) (*pb.InsertResponse, error) {
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	if err := s.InsertWithMetaContext(ctx, in.Vector, in.Id, in.Meta); err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &pb.InsertResponse{}, nil
 }
 
@@ -99,15 +279,49 @@ func (g *GRPC) StreamInsert(srv pb.NGTD_StreamInsertServer) error {
 			return err
 		}
 
-		if err := service.Insert(in.Vector, in.Id); err != nil {
+		s, err := service.GetCollection(in.Collection)
+		if err != nil {
+			srv.Send(&pb.InsertResponse{Error: err.Error()})
+			continue
+		}
+		if err := s.InsertWithMetaContext(srv.Context(), in.Vector, in.Id, in.Meta); err != nil {
 			srv.Send(&pb.InsertResponse{Error: err.Error()})
 		}
 	}
 }
 
+// Upsert inserts the vector, or replaces the vector behind the ID if it already exists.
+func (g *GRPC) Upsert(ctx context.Context, in *pb.InsertRequest) (*pb.UpsertResponse, error) {
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	replaced, err := s.UpsertContext(ctx, in.Vector, in.Id, in.Meta)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &pb.UpsertResponse{Replaced: replaced}, nil
+}
+
+// Update replaces the vector behind the ID. It fails if the ID does not exist.
+func (g *GRPC) Update(ctx context.Context, in *pb.InsertRequest) (*pb.UpsertResponse, error) {
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	if err := s.UpdateContext(ctx, in.Vector, in.Id, in.Meta); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &pb.UpsertResponse{Replaced: true}, nil
+}
+
 func (g *GRPC) Remove(ctx context.Context, in *pb.RemoveRequest) (*pb.RemoveResponse, error) {
-	if err := service.Remove(in.Id); err != nil {
-		return nil, err
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	if err := s.RemoveContext(ctx, in.Id); err != nil {
+		return nil, toGRPCError(err)
 	}
 	return &pb.RemoveResponse{}, nil
 }
@@ -120,7 +334,12 @@ func (g *GRPC) StreamRemove(srv pb.NGTD_StreamRemoveServer) error {
 		} else if err != nil {
 			return err
 		}
-		if err := service.Remove(in.Id); err != nil {
+		s, err := service.GetCollection(in.Collection)
+		if err != nil {
+			srv.Send(&pb.RemoveResponse{Error: err.Error()})
+			continue
+		}
+		if err := s.RemoveContext(srv.Context(), in.Id); err != nil {
 			srv.Send(&pb.RemoveResponse{Error: err.Error()})
 		}
 	}
@@ -128,11 +347,15 @@ func (g *GRPC) StreamRemove(srv pb.NGTD_StreamRemoveServer) error {
 
 // GetObject returns vector.
 func (g *GRPC) GetObject(ctx context.Context, in *pb.GetObjectRequest) (*pb.GetObjectResponse, error) {
-	result, err := service.GetObject(in.Id)
+	s, err := service.GetCollection(in.Collection)
 	if err != nil {
-		return nil, err
+		return nil, toCollectionError(err)
 	}
-	return &pb.GetObjectResponse{Id: result.Id, Vector: result.Vector}, nil
+	result, err := s.GetObjectContext(ctx, in.Id)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &pb.GetObjectResponse{Id: result.Id, Vector: result.Vector, Meta: result.Meta}, nil
 }
 
 // StreamGetObject returns vector stream.
@@ -144,41 +367,388 @@ func (g *GRPC) StreamGetObject(srv pb.NGTD_StreamGetObjectServer) error {
 		} else if err != nil {
 			return err
 		}
-		result, err := service.GetObject(in.Id)
+		s, err := service.GetCollection(in.Collection)
+		if err != nil {
+			srv.Send(&pb.GetObjectResponse{Error: err.Error()})
+			continue
+		}
+		result, err := s.GetObjectContext(srv.Context(), in.Id)
 		if err != nil {
 			srv.Send(&pb.GetObjectResponse{Error: err.Error()})
 		} else {
-			srv.Send(&pb.GetObjectResponse{Id: result.Id, Vector: result.Vector})
+			srv.Send(&pb.GetObjectResponse{Id: result.Id, Vector: result.Vector, Meta: result.Meta})
 		}
 	}
 }
 
 func (g *GRPC) CreateIndex(ctx context.Context, in *pb.CreateIndexRequest) (*pb.Empty, error) {
-	if err := gongt.CreateIndex(int(in.PoolSize)); err != nil {
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	if err := s.CreateIndex(int(in.PoolSize)); err != nil {
 		return nil, err
 	}
 	return &pb.Empty{}, nil
 }
 
-func (g *GRPC) SaveIndex(ctx context.Context, in *pb.Empty) (*pb.Empty, error) {
-	if err := gongt.SaveIndex(); err != nil {
+func (g *GRPC) SaveIndex(ctx context.Context, in *pb.CollectionRequest) (*pb.Empty, error) {
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	if err := s.SaveIndex(); err != nil {
 		return nil, err
 	}
 	return &pb.Empty{}, nil
 }
 
-func (g *GRPC) GetDimension(ctx context.Context, in *pb.Empty) (*pb.GetDimensionResponse, error) {
-	dim := gongt.GetDim()
+func (g *GRPC) GetDimension(ctx context.Context, in *pb.CollectionRequest) (*pb.GetDimensionResponse, error) {
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	dim := s.GetDim()
 	return &pb.GetDimensionResponse{Dimension: int32(dim)}, nil
 }
 
-func toSearchResponse(s []service.SearchResult) *pb.SearchResponse {
+// ListIDs returns a page of IDs. An empty next cursor in the response means the last page.
+func (g *GRPC) ListIDs(ctx context.Context, in *pb.ListIDsRequest) (*pb.ListIDsResponse, error) {
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	ids, next, err := s.ListIDsContext(ctx, in.Cursor, in.Prefix, int(in.Limit))
+	if err == service.ErrInvalidLimit {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &pb.ListIDsResponse{Ids: ids, Next: next}, nil
+}
+
+// Count returns the number of objects.
+func (g *GRPC) Count(ctx context.Context, in *pb.CollectionRequest) (*pb.CountResponse, error) {
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	n, err := s.CountContext(ctx)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &pb.CountResponse{Count: int64(n)}, nil
+}
+
+// GetStats returns the state of the index. The times are unix nanoseconds, 0 until the index is created or saved.
+func (g *GRPC) GetStats(ctx context.Context, in *pb.CollectionRequest) (*pb.StatsResponse, error) {
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	st, err := s.GetStats()
+	if err != nil {
+		return nil, err
+	}
+	var auto *pb.AutoIndexStatus
+	if a := st.AutoIndex; a != nil {
+		auto = &pb.AutoIndexStatus{
+			Pending:         int64(a.Pending),
+			IntervalSeconds: a.Interval.Seconds(),
+			PoolSize:        int32(a.PoolSize),
+			Runs:            int64(a.Runs),
+			LastRun:         unixNano(a.LastRun),
+			LastRunSeconds:  a.LastDuration.Seconds(),
+		}
+		if a.LastError != nil {
+			auto.LastError = a.LastError.Error()
+		}
+	}
+	return &pb.StatsResponse{
+		Objects:            int64(st.Objects),
+		NgtObjects:         int64(st.NGTObjects),
+		IndexedObjects:     int64(st.IndexedObjects),
+		UnindexedObjects:   int64(st.UnindexedObjects),
+		UnsavedObjects:     int64(st.UnsavedObjects),
+		LastCreateIndex:    unixNano(st.LastCreateIndex),
+		CreateIndexSeconds: st.CreateIndexDuration.Seconds(),
+		LastSaveIndex:      unixNano(st.LastSaveIndex),
+		SaveIndexSeconds:   st.SaveIndexDuration.Seconds(),
+		IndexSize:          st.IndexSize,
+		KvsType:            st.KVSType,
+		UptimeSeconds:      st.Uptime.Seconds(),
+		AutoIndex:          auto,
+		AutoSave:           autoSaveStatus(st.AutoSave),
+	}, nil
+}
+
+// Health reports whether the index can keep serving without losing data. Healthy is false with the problems in Errors.
+func (g *GRPC) Health(ctx context.Context, in *pb.CollectionRequest) (*pb.HealthResponse, error) {
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	h := s.GetHealth()
+	res := &pb.HealthResponse{
+		Healthy:        h.Healthy(),
+		LastSaveIndex:  unixNano(h.LastSaveIndex),
+		UnsavedObjects: int64(h.UnsavedObjects),
+		AutoSave:       autoSaveStatus(h.AutoSave),
+	}
+	for _, e := range h.Errors {
+		res.Errors = append(res.Errors, e.Error())
+	}
+	return res, nil
+}
+
+// Snapshot streams a gzipped tar of the index, a dump of the KVS and the manifest with their checksums in chunks.
+// Writes wait while the index is saved and copied, but not while the snapshot is sent.
+func (g *GRPC) Snapshot(in *pb.CollectionRequest, srv pb.NGTD_SnapshotServer) error {
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return toCollectionError(err)
+	}
+	sn, err := s.TakeSnapshot()
+	if err != nil {
+		return toGRPCError(err)
+	}
+	defer sn.Close()
+	w := bufio.NewWriterSize(snapshotWriter{srv}, snapshotChunkSize)
+	if _, err := sn.WriteTo(w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// snapshotWriter sends what is written as SnapshotResponse of up to snapshotChunkSize bytes.
+type snapshotWriter struct {
+	srv pb.NGTD_SnapshotServer
+}
+
+func (w snapshotWriter) Write(b []byte) (int, error) {
+	for n := 0; n < len(b); n += snapshotChunkSize {
+		end := n + snapshotChunkSize
+		if end > len(b) {
+			end = len(b)
+		}
+		// the message is marshaled by Send, so b is not kept
+		if err := w.srv.Send(&pb.SnapshotResponse{Data: b[n:end]}); err != nil {
+			return n, err
+		}
+	}
+	return len(b), nil
+}
+
+func autoSaveStatus(a *service.AutoSaveStatus) *pb.AutoSaveStatus {
+	if a == nil {
+		return nil
+	}
+	res := &pb.AutoSaveStatus{
+		Unsaved:         int64(a.Unsaved),
+		IntervalSeconds: a.Interval.Seconds(),
+		Runs:            int64(a.Runs),
+		LastRun:         unixNano(a.LastRun),
+		LastRunSeconds:  a.LastDuration.Seconds(),
+	}
+	if a.LastError != nil {
+		res.LastError = a.LastError.Error()
+	}
+	return res
+}
+
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// FindDuplicates streams pairs of objects within the threshold with the progress.
+// Each response carries the pairs found since the previous one, and the last one is sent once the search completes.
+// Canceling the stream stops the search.
+func (g *GRPC) FindDuplicates(in *pb.DuplicatesRequest, srv pb.NGTD_FindDuplicatesServer) error {
+	s, err := service.GetCollection(in.Collection)
+	if err != nil {
+		return toCollectionError(err)
+	}
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+	var (
+		pairs       []*pb.DuplicatePair
+		done, total int
+		sendErr     error
+	)
+	last := time.Now()
+	err = s.FindDuplicates(ctx, in.Threshold, in.Epsilon, func(d, n int) {
+		done, total = d, n
+		if len(pairs) < rangeChunkSize && time.Since(last) < progressInterval {
+			return
+		}
+		sendErr = srv.Send(&pb.DuplicatesResponse{
+			Pairs: pairs,
+			Done:  int32(done),
+			Total: int32(total),
+		})
+		if sendErr != nil {
+			cancel()
+		}
+		pairs = nil
+		last = time.Now()
+	}, func(p service.DuplicatePair) error {
+		pairs = append(pairs, &pb.DuplicatePair{
+			Id1:      p.ID1,
+			Id2:      p.ID2,
+			Distance: p.Distance,
+		})
+		return nil
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if err != nil {
+		return toGRPCError(err)
+	}
+	// done may stay below total if objects are removed during the search
+	return srv.Send(&pb.DuplicatesResponse{
+		Pairs: pairs,
+		Done:  int32(done),
+		Total: int32(total),
+	})
+}
+
+// Reload swaps the default index and KVS for the ones in the request, or the ones the server started with.
+func (g *GRPC) Reload(ctx context.Context, in *pb.ReloadRequest) (*pb.Empty, error) {
+	switch err := service.Reload(in.Index, in.DatabasePath); err {
+	case nil:
+		return &pb.Empty{}, nil
+	case service.ErrReloadDisabled:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case service.ErrReloadNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case service.ErrReloadServed, service.ErrReloadDimension:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return nil, err
+	}
+}
+
+// CreateCollection creates a named collection with its own index and KVS.
+func (g *GRPC) CreateCollection(ctx context.Context, in *pb.Collection) (*pb.Empty, error) {
+	err := service.CreateCollection(service.CollectionConfig{
+		Name:         in.Name,
+		Dimension:    int(in.Dimension),
+		DistanceType: in.DistanceType,
+		ObjectType:   in.ObjectType,
+		Normalize:    in.Normalize,
+	})
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	return &pb.Empty{}, nil
+}
+
+// DropCollection closes a named collection and removes its data.
+func (g *GRPC) DropCollection(ctx context.Context, in *pb.CollectionRequest) (*pb.Empty, error) {
+	if err := service.DropCollection(in.Collection); err != nil {
+		return nil, toCollectionError(err)
+	}
+	return &pb.Empty{}, nil
+}
+
+// ListCollections returns all named collections.
+func (g *GRPC) ListCollections(ctx context.Context, in *pb.Empty) (*pb.ListCollectionsResponse, error) {
+	cfgs, err := service.ListCollections()
+	if err != nil {
+		return nil, toCollectionError(err)
+	}
+	ret := make([]*pb.Collection, len(cfgs))
+	for i, cfg := range cfgs {
+		ret[i] = &pb.Collection{
+			Name:         cfg.Name,
+			Dimension:    int32(cfg.Dimension),
+			DistanceType: cfg.DistanceType,
+			ObjectType:   cfg.ObjectType,
+			Normalize:    cfg.Normalize,
+		}
+	}
+	return &pb.ListCollectionsResponse{Collections: ret}, nil
+}
+
+// toRadius returns the radius of the request, or a negative one that means unlimited if it is not given.
+// proto3 cannot tell 0 from an unset field, so radius 0 is given only with has_radius.
+func toRadius(in *pb.SearchRequest) float32 {
+	if in.Radius == 0 && !in.HasRadius {
+		return -1.0
+	}
+	return in.Radius
+}
+
+// toSearchOptions converts optional fields of the request into service.SearchOption.
+func toSearchOptions(in *pb.SearchRequest) (*searchParams, error) {
+	mode, err := service.ScoreMode(in.ScoreMode)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	opts := []service.SearchOption{
+		service.WithMeta(in.WithMeta),
+		service.WithMaxCandidates(int(in.MaxCandidates)),
+		service.WithRadius(toRadius(in)),
+		service.WithExcludeSelf(in.ExcludeSelf),
+		service.WithExcludeIDs(in.ExcludeIds),
+		service.WithRerank(int(in.RerankCandidates), in.RerankMetric),
+		service.WithScoreMode(mode),
+	}
+	var stats *service.SearchStats
+	if in.WithStats {
+		stats = &service.SearchStats{}
+		opts = append(opts, service.WithStats(stats))
+	}
+	if in.Filter != "" {
+		f, err := filter.Parse(in.Filter)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		opts = append(opts, service.WithFilter(f))
+	}
+	return &searchParams{
+		opts:      opts,
+		stats:     stats,
+		scoreMode: mode,
+	}, nil
+}
+
+func toExamples(examples []*pb.Example) []service.Example {
+	ret := make([]service.Example, len(examples))
+	for i, e := range examples {
+		ret[i] = service.Example{
+			ID:     e.Id,
+			Weight: e.Weight,
+		}
+	}
+	return ret
+}
+
+func toTerms(terms []*pb.Term) []service.Term {
+	ret := make([]service.Term, len(terms))
+	for i, t := range terms {
+		ret[i] = service.Term{
+			ID:     t.Id,
+			Vector: t.Vector,
+			Weight: t.Weight,
+		}
+	}
+	return ret
+}
+
+func toSearchResponse(s []service.SearchResult, p *searchParams) *pb.SearchResponse {
 	ret := make([]*pb.ObjectDistance, len(s))
 	for i, r := range s {
 		if r.Error == nil {
 			ret[i] = &pb.ObjectDistance{
 				Id:       r.Id,
+				Meta:     r.Meta,
 				Distance: r.Distance,
+				Score:    r.Score,
 			}
 		} else {
 			ret[i] = &pb.ObjectDistance{
@@ -186,7 +756,61 @@ func toSearchResponse(s []service.SearchResult) *pb.SearchResponse {
 			}
 		}
 	}
-	return &pb.SearchResponse{
-		Result: ret,
+	res := &pb.SearchResponse{
+		Result:    ret,
+		ScoreMode: p.scoreMode,
+		Truncated: p.truncated,
+	}
+	if p.stats != nil {
+		res.Stats = &pb.SearchStats{
+			SearchMs:   toMS(p.stats.Search),
+			RerankMs:   toMS(p.stats.Rerank),
+			TotalMs:    toMS(p.stats.Total),
+			Candidates: int32(p.stats.Candidates),
+		}
+	}
+	return res
+}
+
+// toCollectionError returns err with the code matching the status the HTTP API reports for the collection error.
+func toCollectionError(err error) error {
+	switch err {
+	case service.ErrCollectionNotFound, service.ErrCollectionsDisabled:
+		return status.Error(codes.NotFound, err.Error())
+	case service.ErrCollectionAlreadyExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case service.ErrInvalidCollectionName,
+		service.ErrInvalidCollectionDim,
+		service.ErrUnsupportedDistanceType,
+		service.ErrUnsupportedObjectType,
+		service.ErrNormalizeUnsupported:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+// toGRPCError returns err with codes.InvalidArgument if it is caused by the vector, the examples, the terms, the radius,
+// the rerank metric or the score mode in the request, with codes.NotFound if the ID in the request is not found,
+// and with codes.DeadlineExceeded or codes.Canceled if the request stopped with its context.
+func toGRPCError(err error) error {
+	if service.IsVectorError(err) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	switch err {
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case context.Canceled:
+		return status.Error(codes.Canceled, err.Error())
+	case service.ErrIDNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case service.ErrNoPositiveExample,
+		service.ErrInvalidWeight,
+		service.ErrEmptyExpression,
+		service.ErrInvalidTerm,
+		service.ErrInvalidRadius,
+		service.ErrUnsupportedDistanceType,
+		service.ErrUnsupportedScoreMode:
+		return status.Error(codes.InvalidArgument, err.Error())
 	}
+	return err
 }
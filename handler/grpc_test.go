@@ -21,6 +21,9 @@ import (
 	"testing"
 
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/yahoojapan/gongt"
 	pb "github.com/yahoojapan/ngtd/proto"
@@ -151,7 +154,7 @@ func TestGRPC(t *testing.T) {
 		g := GRPC{}
 		const want = 6
 
-		req := &pb.Empty{}
+		req := &pb.CollectionRequest{}
 		res, err := g.GetDimension(context.Background(), req)
 		if err != nil {
 			t.Errorf("Unexpected error: TestRemove(%v)", err)
@@ -160,4 +163,123 @@ func TestGRPC(t *testing.T) {
 			t.Errorf("TestGetDimension(): %v, wanted: %v", res.Dimension, want)
 		}
 	})
+
+	t.Run("TestDeadline", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		g := GRPC{}
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+
+		req := &pb.SearchRequest{Id: []byte("a"), Size_: 1, Epsilon: gongt.DefaultEpsilon}
+		if _, err := g.SearchByID(ctx, req); status.Code(err) != codes.DeadlineExceeded {
+			t.Errorf("TestDeadline(SearchByID): %v, wanted: %v", err, codes.DeadlineExceeded)
+		}
+		if _, err := g.GetObject(ctx, &pb.GetObjectRequest{Id: []byte("a")}); status.Code(err) != codes.DeadlineExceeded {
+			t.Errorf("TestDeadline(GetObject): %v, wanted: %v", err, codes.DeadlineExceeded)
+		}
+	})
+
+	t.Run("TestCollectionError", func(t *testing.T) {
+		g := GRPC{}
+		ctx := context.Background()
+
+		// collections are disabled in the tests
+		if _, err := g.GetDimension(ctx, &pb.CollectionRequest{Collection: "x"}); status.Code(err) != codes.NotFound {
+			t.Errorf("TestCollectionError(GetDimension): %v, wanted: %v", err, codes.NotFound)
+		}
+		if _, err := g.ListCollections(ctx, &pb.Empty{}); status.Code(err) != codes.NotFound {
+			t.Errorf("TestCollectionError(ListCollections): %v, wanted: %v", err, codes.NotFound)
+		}
+		if _, err := g.CreateCollection(ctx, &pb.Collection{Name: "a b", Dimension: 6}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("TestCollectionError(CreateCollection): %v, wanted: %v", err, codes.InvalidArgument)
+		}
+	})
+
+	t.Run("TestFindDuplicates", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		g := GRPC{}
+		srv := &duplicatesStream{ctx: context.Background()}
+		if err := g.FindDuplicates(&pb.DuplicatesRequest{Threshold: 1.5}, srv); err != nil {
+			t.Fatalf("Unexpected error: TestFindDuplicates(%v)", err)
+		}
+		pairs := 0
+		for _, res := range srv.res {
+			pairs += len(res.Pairs)
+		}
+		// every pair of a..f is within sqrt(2)
+		if last := srv.res[len(srv.res)-1]; pairs != 15 || last.Done != 6 || last.Total != 6 {
+			t.Errorf("TestFindDuplicates: %v pairs, %v/%v, wanted: 15 pairs, 6/6", pairs, last.Done, last.Total)
+		}
+	})
+
+	t.Run("TestStatus", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		g := GRPC{}
+		ctx := context.Background()
+		search := func(req *pb.SearchRequest) func() error {
+			return func() error {
+				req.Vector = []float64{1, 0, 0, 0, 0, 0}
+				req.Size_ = 1
+				_, err := g.Search(ctx, req)
+				return err
+			}
+		}
+		tests := []struct{
+			name string
+			f    func() error
+			want codes.Code
+		}{
+			{"malformed filter", search(&pb.SearchRequest{Filter: "price <"}), codes.InvalidArgument},
+			{"update of missing ID", func() error {
+				_, err := g.Update(ctx, &pb.InsertRequest{Id: []byte("x"), Vector: []float64{1, 0, 0, 0, 0, 0}})
+				return err
+			}, codes.NotFound},
+			{"search by missing ID", func() error {
+				_, err := g.SearchByID(ctx, &pb.SearchRequest{Id: []byte("x"), Size_: 1})
+				return err
+			}, codes.NotFound},
+			{"negative radius", func() error {
+				return g.SearchRange(&pb.SearchRequest{Vector: []float64{1, 0, 0, 0, 0, 0}, Radius: -1, HasRadius: true}, &rangeStream{ctx: ctx})
+			}, codes.InvalidArgument},
+			{"unsupported rerank metric", search(&pb.SearchRequest{RerankCandidates: 3, RerankMetric: "jaccard"}), codes.InvalidArgument},
+			{"unsupported score mode", search(&pb.SearchRequest{ScoreMode: "rank"}), codes.InvalidArgument},
+		}
+		for _, tt := range tests {
+			if err := tt.f(); status.Code(err) != tt.want {
+				t.Errorf("TestStatus(%v): %v, wanted: %v", tt.name, err, tt.want)
+			}
+		}
+	})
+}
+
+// rangeStream collects the responses of a streaming search.
+type rangeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	res []*pb.SearchResponse
+}
+
+func (s *rangeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *rangeStream) Send(res *pb.SearchResponse) error {
+	s.res = append(s.res, res)
+	return nil
+}
+
+// duplicatesStream collects the responses of FindDuplicates.
+type duplicatesStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	res []*pb.DuplicatesResponse
+}
+
+func (s *duplicatesStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *duplicatesStream) Send(res *pb.DuplicatesResponse) error {
+	s.res = append(s.res, res)
+	return nil
 }
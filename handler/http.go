@@ -17,17 +17,20 @@
 package handler
 
 import (
+	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"time"
 	"unsafe"
 
 	"github.com/gorilla/mux"
 	"github.com/kpango/glg"
-	"github.com/yahoojapan/gongt"
+	"github.com/yahoojapan/ngtd/filter"
 	"github.com/yahoojapan/ngtd/model"
 	"github.com/yahoojapan/ngtd/service"
 )
@@ -50,18 +53,28 @@ func Search(w http.ResponseWriter, r *http.Request) {
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
 
-	result, err := service.Search(reqBody.Vector, reqBody.Size, reqBody.Epsilon)
+	p, err := searchOptions(&reqBody)
 	if err != nil {
 		ErrorResponse(w,
-			http.StatusInternalServerError,
-			"Search Error",
+			http.StatusBadRequest,
+			"Invalid Search Option",
 			err)
 		return
 	}
+
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	result, err := s.SearchContext(r.Context(), reqBody.Vector, reqBody.Size, reqBody.Epsilon, p.opts...)
+	if err != nil {
+		SearchErrorResponse(w, err)
+		return
+	}
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(model.SearchResponse{
-		Result: toModelSearchResult(result),
-	})
+	json.NewEncoder(w).Encode(toModelSearchResponse(result, p))
 }
 
 func SearchByID(w http.ResponseWriter, r *http.Request) {
@@ -78,17 +91,242 @@ func SearchByID(w http.ResponseWriter, r *http.Request) {
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
 
-	result, err := service.SearchByID(*(*[]byte)(unsafe.Pointer(&reqBody.ID)), reqBody.Size, reqBody.Epsilon)
+	p, err := searchOptions(&reqBody)
 	if err != nil {
 		ErrorResponse(w,
-			http.StatusInternalServerError,
-			"Search Error",
+			http.StatusBadRequest,
+			"Invalid Search Option",
+			err)
+		return
+	}
+
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	result, err := s.SearchByIDContext(r.Context(), *(*[]byte)(unsafe.Pointer(&reqBody.ID)), reqBody.Size, reqBody.Epsilon, p.opts...)
+	if err != nil {
+		SearchErrorResponse(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(toModelSearchResponse(result, p))
+}
+
+// SearchByExamples searches the objects like the positive examples and unlike the negative ones.
+func SearchByExamples(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	var reqBody model.SearchRequest
+	err := json.NewDecoder(r.Body).Decode(&reqBody)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid JSON Format",
+			err)
+		return
+	}
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	p, err := searchOptions(&reqBody)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid Search Option",
+			err)
+		return
+	}
+
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	result, err := s.SearchByExamplesContext(r.Context(), toServiceExamples(reqBody.Positive), toServiceExamples(reqBody.Negative), reqBody.Size, reqBody.Epsilon, p.opts...)
+	if err != nil {
+		SearchErrorResponse(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(toModelSearchResponse(result, p))
+}
+
+// SearchByExpression searches with the weighted sum of stored objects and literal vectors.
+func SearchByExpression(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	var reqBody model.SearchRequest
+	err := json.NewDecoder(r.Body).Decode(&reqBody)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid JSON Format",
+			err)
+		return
+	}
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	p, err := searchOptions(&reqBody)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid Search Option",
+			err)
+		return
+	}
+
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	result, err := s.SearchByExpressionContext(r.Context(), toServiceTerms(reqBody.Terms), reqBody.Size, reqBody.Epsilon, p.opts...)
+	if err != nil {
+		SearchErrorResponse(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(toModelSearchResponse(result, p))
+}
+
+// SearchRange returns all objects within the radius from the vector.
+func SearchRange(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	var reqBody model.SearchRequest
+	err := json.NewDecoder(r.Body).Decode(&reqBody)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid JSON Format",
+			err)
+		return
+	}
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	p, err := searchOptions(&reqBody)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid Search Option",
+			err)
+		return
+	}
+
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	result, err := s.SearchRangeContext(r.Context(), reqBody.Vector, radius(reqBody.Radius), reqBody.Epsilon, append(p.opts, service.WithTruncated(&p.truncated))...)
+	if err != nil {
+		SearchErrorResponse(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(toModelSearchResponse(result, p))
+}
+
+// SearchRangeByID returns all objects within the radius from the object of the ID.
+func SearchRangeByID(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	var reqBody model.SearchRequest
+	err := json.NewDecoder(r.Body).Decode(&reqBody)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid JSON Format",
+			err)
+		return
+	}
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	p, err := searchOptions(&reqBody)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid Search Option",
+			err)
+		return
+	}
+
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	result, err := s.SearchRangeByIDContext(r.Context(), *(*[]byte)(unsafe.Pointer(&reqBody.ID)), radius(reqBody.Radius), reqBody.Epsilon, append(p.opts, service.WithTruncated(&p.truncated))...)
+	if err != nil {
+		SearchErrorResponse(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(toModelSearchResponse(result, p))
+}
+
+// MultiSearch runs the search requests in parallel and returns the results in the request order.
+func MultiSearch(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	var reqBody model.MultiSearchRequest
+	err := json.NewDecoder(r.Body).Decode(&reqBody)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid JSON Format",
 			err)
 		return
 	}
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	results := make([]model.MultiSearchResult, len(reqBody.SearchRequests))
+	queries := make([]service.SearchQuery, 0, len(reqBody.SearchRequests))
+	idx := make([]int, 0, len(reqBody.SearchRequests))
+	params := make([]*searchParams, 0, len(reqBody.SearchRequests))
+	for i := range reqBody.SearchRequests {
+		req := &reqBody.SearchRequests[i]
+		p, err := searchOptions(req)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		params = append(params, p)
+		queries = append(queries, service.SearchQuery{
+			Vector:  req.Vector,
+			ID:      *(*[]byte)(unsafe.Pointer(&req.ID)),
+			Size:    req.Size,
+			Epsilon: req.Epsilon,
+			Options: p.opts,
+		})
+		idx = append(idx, i)
+	}
+
+	for i, res := range s.MultiSearchContext(r.Context(), queries) {
+		if res.Error != nil {
+			results[idx[i]].Error = res.Error.Error()
+		} else {
+			sr := toModelSearchResponse(res.Result, params[i])
+			results[idx[i]].Result = sr.Result
+			results[idx[i]].Stats = sr.Stats
+			results[idx[i]].ScoreMode = sr.ScoreMode
+		}
+	}
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(model.SearchResponse{
-		Result: toModelSearchResult(result),
+	json.NewEncoder(w).Encode(model.MultiSearchResponse{
+		Results: results,
 	})
 }
 
@@ -106,8 +344,20 @@ func Insert(w http.ResponseWriter, r *http.Request) {
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
 
-	err = service.Insert(reqBody.Vector, *(*[]byte)(unsafe.Pointer(&reqBody.ID)))
+	s, err := getService(r)
 	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	err = s.InsertWithMetaContext(r.Context(), reqBody.Vector, *(*[]byte)(unsafe.Pointer(&reqBody.ID)), reqBody.Meta)
+	if service.IsVectorError(err) {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid Vector",
+			err)
+		return
+	} else if err != nil {
 		ErrorResponse(w,
 			http.StatusInternalServerError,
 			"Insert Failed",
@@ -121,6 +371,98 @@ func Insert(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// Upsert inserts the vector, or replaces the vector behind the ID if it already exists.
+func Upsert(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	var reqBody model.InsertRequest
+	err := json.NewDecoder(r.Body).Decode(&reqBody)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid JSON Format",
+			err)
+		return
+	}
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	replaced, err := s.UpsertContext(r.Context(), reqBody.Vector, *(*[]byte)(unsafe.Pointer(&reqBody.ID)), reqBody.Meta)
+	if service.IsVectorError(err) {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid Vector",
+			err)
+		return
+	} else if err != nil {
+		ErrorResponse(w,
+			http.StatusInternalServerError,
+			"Upsert Failed",
+			err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.UpsertResponse{
+		Status:   "Success",
+		Replaced: replaced,
+	})
+}
+
+// Update replaces the vector behind the ID. It responds 404 if the ID does not exist.
+func Update(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	var reqBody model.InsertRequest
+	err := json.NewDecoder(r.Body).Decode(&reqBody)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid JSON Format",
+			err)
+		return
+	}
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	err = s.UpdateContext(r.Context(), reqBody.Vector, *(*[]byte)(unsafe.Pointer(&reqBody.ID)), reqBody.Meta)
+	if err == service.ErrIDNotFound {
+		ErrorResponse(w,
+			http.StatusNotFound,
+			"ID Not Found",
+			err)
+		return
+	} else if service.IsVectorError(err) {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid Vector",
+			err)
+		return
+	} else if err != nil {
+		ErrorResponse(w,
+			http.StatusInternalServerError,
+			"Update Failed",
+			err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.UpsertResponse{
+		Status:   "Success",
+		Replaced: true,
+	})
+}
+
 func MultiInsert(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	var reqBody model.MultiInsertRequest
@@ -135,9 +477,15 @@ func MultiInsert(w http.ResponseWriter, r *http.Request) {
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
 
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
 	errs := make([]error, 0, len(reqBody.InsertRequests))
 	for _, insertRequest := range reqBody.InsertRequests {
-		err := service.Insert(insertRequest.Vector, *(*[]byte)(unsafe.Pointer(&insertRequest.ID)))
+		err := s.InsertWithMetaContext(r.Context(), insertRequest.Vector, *(*[]byte)(unsafe.Pointer(&insertRequest.ID)), insertRequest.Meta)
 		if err != nil {
 			errs = append(errs, err)
 		}
@@ -164,7 +512,12 @@ func Remove(w http.ResponseWriter, r *http.Request) {
 	id := mux.Vars(r)["id"]
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
-	err := service.Remove([]byte(id))
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+	err = s.RemoveContext(r.Context(), []byte(id))
 	if err != nil {
 		ErrorResponse(w,
 			http.StatusInternalServerError,
@@ -193,9 +546,15 @@ func MultiRemove(w http.ResponseWriter, r *http.Request) {
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
 
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
 	errs := make([]error, 0, len(reqBody.IDs))
 	for _, id := range reqBody.IDs {
-		err := service.Remove(*(*[]byte)(unsafe.Pointer(&id)))
+		err := s.RemoveContext(r.Context(), *(*[]byte)(unsafe.Pointer(&id)))
 		if err != nil {
 			errs = append(errs, err)
 		}
@@ -230,7 +589,13 @@ func CreateIndex(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = gongt.CreateIndex(poolSize)
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	err = s.CreateIndex(poolSize)
 	if err != nil {
 		ErrorResponse(w,
 			http.StatusInternalServerError,
@@ -250,9 +615,14 @@ func SaveIndex(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
-	err := gongt.SaveIndex()
+	s, err := getService(r)
 	if err != nil {
-		ErrorResponse(w,
+		CollectionErrorResponse(w, err)
+		return
+	}
+	err = s.SaveIndex()
+	if err != nil {
+		ErrorResponse(w,
 			http.StatusInternalServerError,
 			"SaveIndex Failed",
 			err)
@@ -270,10 +640,15 @@ func GetErrors(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
 	json.NewEncoder(w).Encode(struct {
 		Errors []error `json:"errors"`
 	}{
-		Errors: gongt.GetErrors(),
+		Errors: s.GetErrors(),
 	})
 }
 
@@ -281,13 +656,235 @@ func GetDimension(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
 	json.NewEncoder(w).Encode(struct {
 		Dimension int `json:"dimension"`
 	}{
-		Dimension: gongt.GetDim(),
+		Dimension: s.GetDim(),
 	})
 }
 
+// ListIDs returns a page of IDs. The query takes the cursor of the page, prefix of IDs and limit of the page.
+func ListIDs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	q := r.URL.Query()
+	cursor, err := base64.RawURLEncoding.DecodeString(q.Get("cursor"))
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid Cursor",
+			err)
+		return
+	}
+	limit := 0
+	if l := q.Get("limit"); l != "" {
+		limit, err = strconv.Atoi(l)
+		if err != nil {
+			ErrorResponse(w,
+				http.StatusBadRequest,
+				"Invalid Limit",
+				err)
+			return
+		}
+	}
+
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	ids, next, err := s.ListIDsContext(r.Context(), cursor, []byte(q.Get("prefix")), limit)
+	if err == service.ErrInvalidLimit {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid Limit",
+			err)
+		return
+	} else if err != nil {
+		ErrorResponse(w,
+			http.StatusInternalServerError,
+			"List IDs Failed",
+			err)
+		return
+	}
+
+	ret := make([]string, len(ids))
+	for i, id := range ids {
+		ret[i] = string(id)
+	}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.ListIDsResponse{
+		IDs:  ret,
+		Next: base64.RawURLEncoding.EncodeToString(next),
+	})
+}
+
+// Count returns the number of objects.
+func Count(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+	n, err := s.CountContext(r.Context())
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusInternalServerError,
+			"Count Failed",
+			err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.CountResponse{
+		Count: n,
+	})
+}
+
+// GetStats returns the state of the index.
+func GetStats(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+	st, err := s.GetStats()
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusInternalServerError,
+			"GetStats Failed",
+			err)
+		return
+	}
+	var auto *model.AutoIndexResponse
+	if a := st.AutoIndex; a != nil {
+		auto = &model.AutoIndexResponse{
+			Pending:         a.Pending,
+			IntervalSeconds: a.Interval.Seconds(),
+			PoolSize:        a.PoolSize,
+			Runs:            a.Runs,
+			LastRun:         timeOrNil(a.LastRun),
+			LastRunSeconds:  a.LastDuration.Seconds(),
+		}
+		if a.LastError != nil {
+			auto.LastError = a.LastError.Error()
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.StatsResponse{
+		Objects:            st.Objects,
+		NGTObjects:         st.NGTObjects,
+		IndexedObjects:     st.IndexedObjects,
+		UnindexedObjects:   st.UnindexedObjects,
+		UnsavedObjects:     st.UnsavedObjects,
+		LastCreateIndex:    timeOrNil(st.LastCreateIndex),
+		CreateIndexSeconds: st.CreateIndexDuration.Seconds(),
+		LastSaveIndex:      timeOrNil(st.LastSaveIndex),
+		SaveIndexSeconds:   st.SaveIndexDuration.Seconds(),
+		IndexSize:          st.IndexSize,
+		KVSType:            st.KVSType,
+		UptimeSeconds:      st.Uptime.Seconds(),
+		AutoIndex:          auto,
+		AutoSave:           autoSaveResponse(st.AutoSave),
+	})
+}
+
+// Health reports whether the index can keep serving without losing data.
+// It responds 503 with the problems if it cannot, so that it can be used as a health check.
+func Health(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+	h := s.GetHealth()
+	res := model.HealthResponse{
+		Status:         "ok",
+		LastSaveIndex:  timeOrNil(h.LastSaveIndex),
+		UnsavedObjects: h.UnsavedObjects,
+		AutoSave:       autoSaveResponse(h.AutoSave),
+	}
+	code := http.StatusOK
+	if !h.Healthy() {
+		code = http.StatusServiceUnavailable
+		res.Status = "unhealthy"
+		for _, e := range h.Errors {
+			res.Errors = append(res.Errors, e.Error())
+		}
+	}
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Snapshot responds a gzipped tar of the index, a dump of the KVS and the manifest with their checksums.
+// Writes wait while the index is saved and copied, but not while the snapshot is sent.
+func Snapshot(w http.ResponseWriter, r *http.Request) {
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+	s, err := getService(r)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		CollectionErrorResponse(w, err)
+		return
+	}
+	sn, err := s.TakeSnapshot()
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		ErrorResponse(w,
+			http.StatusInternalServerError,
+			"Snapshot Failed",
+			err)
+		return
+	}
+	defer sn.Close()
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", `attachment; filename="ngtd-snapshot.tar.gz"`)
+	w.WriteHeader(http.StatusOK)
+	if _, err := sn.WriteTo(w); err != nil {
+		glg.Error(err)
+	}
+}
+
+func autoSaveResponse(a *service.AutoSaveStatus) *model.AutoSaveResponse {
+	if a == nil {
+		return nil
+	}
+	res := &model.AutoSaveResponse{
+		Unsaved:         a.Unsaved,
+		IntervalSeconds: a.Interval.Seconds(),
+		Runs:            a.Runs,
+		LastRun:         timeOrNil(a.LastRun),
+		LastRunSeconds:  a.LastDuration.Seconds(),
+	}
+	if a.LastError != nil {
+		res.LastError = a.LastError.Error()
+	}
+	return res
+}
+
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
 // GetObjects returns vectors.
 func GetObjects(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
@@ -303,16 +900,23 @@ func GetObjects(w http.ResponseWriter, r *http.Request) {
 	io.Copy(ioutil.Discard, r.Body)
 	r.Body.Close()
 
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
 	results := make([]model.GetObjectResult, 0, len(reqBody.IDs))
 	errs := make([]string, 0, len(reqBody.IDs))
 	for _, id := range reqBody.IDs {
-		result, err := service.GetObject(*(*[]byte)(unsafe.Pointer(&id)))
+		result, err := s.GetObjectContext(r.Context(), *(*[]byte)(unsafe.Pointer(&id)))
 		if err != nil {
 			errs = append(errs, fmt.Sprintf("Error: GetObject(%s) caused %s", id, err.Error()))
 		} else {
 			results = append(results, model.GetObjectResult{
 				ID:     *(*string)(unsafe.Pointer(&result.Id)),
 				Vector: result.Vector,
+				Meta:   toRawMeta(result.Meta),
 			})
 		}
 	}
@@ -323,7 +927,286 @@ func GetObjects(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// ListCollections returns all named collections.
+func ListCollections(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	cfgs, err := service.ListCollections()
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+	ret := make([]model.Collection, len(cfgs))
+	for i, cfg := range cfgs {
+		ret[i] = model.Collection{
+			Name:         cfg.Name,
+			Dimension:    cfg.Dimension,
+			DistanceType: cfg.DistanceType,
+			ObjectType:   cfg.ObjectType,
+			Normalize:    cfg.Normalize,
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.ListCollectionsResponse{
+		Collections: ret,
+	})
+}
+
+// CreateCollection creates a named collection with its own index and KVS.
+func CreateCollection(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	var reqBody model.Collection
+	err := json.NewDecoder(r.Body).Decode(&reqBody)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid JSON Format",
+			err)
+		return
+	}
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	err = service.CreateCollection(service.CollectionConfig{
+		Name:         mux.Vars(r)["collection"],
+		Dimension:    reqBody.Dimension,
+		DistanceType: reqBody.DistanceType,
+		ObjectType:   reqBody.ObjectType,
+		Normalize:    reqBody.Normalize,
+	})
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.DefaultResponse{
+		Code:    http.StatusOK,
+		Message: "Collection Successfully Created",
+	})
+}
+
+// DropCollection closes a named collection and removes its data.
+func DropCollection(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	if err := service.DropCollection(mux.Vars(r)["collection"]); err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.DefaultResponse{
+		Code:    http.StatusOK,
+		Message: "Collection Successfully Dropped",
+	})
+}
+
+// StartDuplicates starts a job finding pairs of objects within the threshold.
+func StartDuplicates(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	var reqBody model.DuplicatesRequest
+	err := json.NewDecoder(r.Body).Decode(&reqBody)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid JSON Format",
+			err)
+		return
+	}
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	s, err := getService(r)
+	if err != nil {
+		CollectionErrorResponse(w, err)
+		return
+	}
+
+	j, err := s.StartDuplicatesJob(reqBody.Threshold, reqBody.Epsilon)
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid Threshold",
+			err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(toModelJobResponse(j.Status(), false))
+}
+
+// GetJob returns the progress of the job, and its result once it is done.
+// The result is grouped into clusters if the query has clusters=true.
+func GetJob(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	j, err := service.GetJob(mux.Vars(r)["id"])
+	if err != nil {
+		ErrorResponse(w,
+			http.StatusNotFound,
+			"Job Not Found",
+			err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(toModelJobResponse(j.Status(), r.URL.Query().Get("clusters") == "true"))
+}
+
+// ListJobs returns the progress of all jobs without their results.
+func ListJobs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	jobs := service.ListJobs()
+	ret := make([]model.JobResponse, len(jobs))
+	for i, j := range jobs {
+		st := j.Status()
+		st.Pairs = nil
+		ret[i] = toModelJobResponse(st, false)
+	}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.ListJobsResponse{
+		Jobs: ret,
+	})
+}
+
+// CancelJob stops the job. It is kept as canceled.
+func CancelJob(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	if err := service.CancelJob(mux.Vars(r)["id"]); err != nil {
+		ErrorResponse(w,
+			http.StatusNotFound,
+			"Job Not Found",
+			err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.DefaultResponse{
+		Code:    http.StatusOK,
+		Message: "Job Successfully Canceled",
+	})
+}
+
+// DeleteJob cancels the job if it is running, and forgets it and its result.
+func DeleteJob(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	if err := service.DeleteJob(mux.Vars(r)["id"]); err != nil {
+		ErrorResponse(w,
+			http.StatusNotFound,
+			"Job Not Found",
+			err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.DefaultResponse{
+		Code:    http.StatusOK,
+		Message: "Job Successfully Deleted",
+	})
+}
+
+// Reload swaps the default index and KVS for the ones in the request, or the ones the server started with.
+func Reload(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	var reqBody model.ReloadRequest
+	// an empty body reloads the paths the server started with
+	err := json.NewDecoder(r.Body).Decode(&reqBody)
+	if err != nil && err != io.EOF {
+		ErrorResponse(w,
+			http.StatusBadRequest,
+			"Invalid JSON Format",
+			err)
+		return
+	}
+	io.Copy(ioutil.Discard, r.Body)
+	r.Body.Close()
+
+	switch err := service.Reload(reqBody.Index, reqBody.DatabasePath); err {
+	case nil:
+	case service.ErrReloadDisabled:
+		ErrorResponse(w, http.StatusForbidden, "Reload Disabled", err)
+		return
+	case service.ErrReloadNotFound, service.ErrReloadServed, service.ErrReloadDimension:
+		ErrorResponse(w, http.StatusBadRequest, "Bad Request", err)
+		return
+	default:
+		ErrorResponse(w, http.StatusInternalServerError, "Reload Failed", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.DefaultResponse{
+		Code:    http.StatusOK,
+		Message: "Index Successfully Reloaded",
+	})
+}
+
+// SearchErrorResponse writes the error of search with 400 if it is caused by the search options or the query vector,
+// and with 404 if the ID to search by is not found.
+func SearchErrorResponse(w http.ResponseWriter, err error) {
+	if service.IsVectorError(err) {
+		ErrorResponse(w, http.StatusBadRequest, "Invalid Vector", err)
+		return
+	}
+	switch err {
+	case service.ErrInvalidRadius,
+		service.ErrUnsupportedDistanceType,
+		service.ErrUnsupportedScoreMode,
+		service.ErrNoPositiveExample,
+		service.ErrInvalidWeight,
+		service.ErrEmptyExpression,
+		service.ErrInvalidTerm:
+		ErrorResponse(w, http.StatusBadRequest, "Invalid Search Option", err)
+	case service.ErrIDNotFound:
+		ErrorResponse(w, http.StatusNotFound, "ID Not Found", err)
+	default:
+		ErrorResponse(w, http.StatusInternalServerError, "Search Error", err)
+	}
+}
+
+// CollectionErrorResponse writes the error of collection lookup or management with the matching status code.
+func CollectionErrorResponse(w http.ResponseWriter, err error) {
+	switch err {
+	case service.ErrCollectionNotFound, service.ErrCollectionsDisabled:
+		ErrorResponse(w, http.StatusNotFound, "Collection Not Found", err)
+	case service.ErrCollectionAlreadyExists:
+		ErrorResponse(w, http.StatusConflict, "Collection Already Exists", err)
+	case service.ErrInvalidCollectionName,
+		service.ErrInvalidCollectionDim,
+		service.ErrUnsupportedDistanceType,
+		service.ErrUnsupportedObjectType,
+		service.ErrNormalizeUnsupported:
+		ErrorResponse(w, http.StatusBadRequest, "Bad Request", err)
+	default:
+		ErrorResponse(w, http.StatusInternalServerError, "Collection Error", err)
+	}
+}
+
+// ErrorResponse writes err with code and message. An error of the request context overrides them,
+// so that a request stopped by its deadline is reported as such whatever the handler.
 func ErrorResponse(w http.ResponseWriter, code int, message string, err error) {
+	switch err {
+	case context.DeadlineExceeded:
+		code, message = http.StatusGatewayTimeout, "Deadline Exceeded"
+	case context.Canceled:
+		code, message = http.StatusRequestTimeout, "Canceled"
+	}
 	glg.Error(err)
 	w.WriteHeader(code)
 	json.NewEncoder(w).Encode(model.DefaultResponse{
@@ -333,13 +1216,181 @@ func ErrorResponse(w http.ResponseWriter, code int, message string, err error) {
 	})
 }
 
-func toModelSearchResult(s []service.SearchResult) []model.SearchResult {
+// getService returns Service of the collection in the request path, or the default one.
+func getService(r *http.Request) (*service.Service, error) {
+	return service.GetCollection(mux.Vars(r)["collection"])
+}
+
+// searchParams holds service.SearchOption of a request and what the response reports about the search.
+type searchParams struct {
+	opts []service.SearchOption
+	// stats is filled by the search if the request asks for it, otherwise it is nil.
+	stats     *service.SearchStats
+	scoreMode string
+	// truncated is set by a range search that stopped at the candidate limit.
+	truncated bool
+}
+
+// radius returns the radius of the request, or a negative one that means unlimited if it is not given.
+func radius(r *float32) float32 {
+	if r == nil {
+		return -1.0
+	}
+	return *r
+}
+
+// searchOptions converts optional fields of the request into service.SearchOption.
+func searchOptions(req *model.SearchRequest) (*searchParams, error) {
+	mode, err := service.ScoreMode(req.ScoreMode)
+	if err != nil {
+		return nil, err
+	}
+	opts := []service.SearchOption{
+		service.WithMeta(req.WithMeta),
+		service.WithMaxCandidates(req.MaxCandidates),
+		service.WithRadius(radius(req.Radius)),
+		service.WithExcludeSelf(req.ExcludeSelf),
+		service.WithRerank(req.RerankCandidates, req.RerankMetric),
+		service.WithScoreMode(mode),
+	}
+	var stats *service.SearchStats
+	if req.WithStats {
+		stats = &service.SearchStats{}
+		opts = append(opts, service.WithStats(stats))
+	}
+	if len(req.ExcludeIDs) > 0 {
+		ids := make([][]byte, len(req.ExcludeIDs))
+		for i := range req.ExcludeIDs {
+			ids[i] = *(*[]byte)(unsafe.Pointer(&req.ExcludeIDs[i]))
+		}
+		opts = append(opts, service.WithExcludeIDs(ids))
+	}
+	if req.Filter != "" {
+		f, err := filter.Parse(req.Filter)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, service.WithFilter(f))
+	}
+	return &searchParams{
+		opts:      opts,
+		stats:     stats,
+		scoreMode: mode,
+	}, nil
+}
+
+func toServiceExamples(examples []model.Example) []service.Example {
+	ret := make([]service.Example, len(examples))
+	for i := range examples {
+		ret[i] = service.Example{
+			ID:     *(*[]byte)(unsafe.Pointer(&examples[i].ID)),
+			Weight: examples[i].Weight,
+		}
+	}
+	return ret
+}
+
+func toServiceTerms(terms []model.Term) []service.Term {
+	ret := make([]service.Term, len(terms))
+	for i := range terms {
+		ret[i] = service.Term{
+			ID:     *(*[]byte)(unsafe.Pointer(&terms[i].ID)),
+			Vector: terms[i].Vector,
+			Weight: terms[i].Weight,
+		}
+	}
+	return ret
+}
+
+func toModelSearchResponse(result []service.SearchResult, p *searchParams) model.SearchResponse {
+	return model.SearchResponse{
+		Result:    toModelSearchResult(result, p.scoreMode),
+		Stats:     toModelSearchStats(p.stats),
+		ScoreMode: p.scoreMode,
+		Truncated: p.truncated,
+	}
+}
+
+func toModelSearchStats(s *service.SearchStats) *model.SearchStats {
+	if s == nil {
+		return nil
+	}
+	return &model.SearchStats{
+		SearchMS:   toMS(s.Search),
+		RerankMS:   toMS(s.Rerank),
+		TotalMS:    toMS(s.Total),
+		Candidates: s.Candidates,
+	}
+}
+
+func toMS(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func toModelSearchResult(s []service.SearchResult, scoreMode string) []model.SearchResult {
 	ret := make([]model.SearchResult, len(s))
 	for i, r := range s {
 		ret[i] = model.SearchResult{
 			ID:       *(*string)(unsafe.Pointer(&r.Id)),
 			Distance: r.Distance,
+			Meta:     toRawMeta(r.Meta),
+		}
+		if scoreMode != service.ScoreModeDistance {
+			ret[i].Score = &s[i].Score
+		}
+	}
+	return ret
+}
+
+// toRawMeta returns metadata as JSON. Metadata which is not JSON (e.g. inserted by gRPC) is encoded as a string.
+func toRawMeta(meta []byte) json.RawMessage {
+	if len(meta) == 0 {
+		return nil
+	}
+	if json.Valid(meta) {
+		return meta
+	}
+	b, err := json.Marshal(string(meta))
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+func toModelJobResponse(st service.JobStatus, clusters bool) model.JobResponse {
+	ret := model.JobResponse{
+		ID:      st.ID,
+		Kind:    st.Kind,
+		State:   st.State,
+		Done:    st.Done,
+		Total:   st.Total,
+		Started: st.Started,
+	}
+	if st.Error != nil {
+		ret.Error = st.Error.Error()
+	}
+	if !st.Finished.IsZero() {
+		ret.Finished = &st.Finished
+	}
+	if clusters {
+		for _, c := range service.Clusters(st.Pairs) {
+			ids := make([]string, len(c.IDs))
+			for i, id := range c.IDs {
+				ids[i] = string(id)
+			}
+			ret.Clusters = append(ret.Clusters, model.DuplicateCluster{
+				IDs:         ids,
+				MaxDistance: c.MaxDistance,
+			})
 		}
+		return ret
+	}
+	for _, p := range st.Pairs {
+		ret.Pairs = append(ret.Pairs, model.DuplicatePair{
+			ID1:      string(p.ID1),
+			ID2:      string(p.ID2),
+			Distance: p.Distance,
+		})
 	}
 	return ret
 }
@@ -133,6 +133,51 @@ func TestHTTP(t *testing.T) {
 		}
 	})
 
+	t.Run("TestMultiSearch", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		req := model.MultiSearchRequest{
+			SearchRequests: []model.SearchRequest{
+				{Vector: []float64{1, 0, 0, 0, 0, 0}, Size: 1, Epsilon: gongt.DefaultEpsilon},
+				{ID: "b", Size: 1, Epsilon: gongt.DefaultEpsilon},
+				{Vector: []float64{0, 0, 1, 0, 0, 0}, Size: 1, Epsilon: gongt.DefaultEpsilon, Filter: "category =="},
+				{Vector: []float64{0, 0, 0, 1, 0, 0}, Size: 1, Epsilon: gongt.DefaultEpsilon},
+			},
+		}
+		want := []string{"a", "b", "", "d"}
+		reqBody, err := json.Marshal(req)
+		if err != nil {
+			t.Errorf("Unexpected error: TestMultiSearch(%v)", err)
+		}
+
+		r, err := http.NewRequest(http.MethodPost, "/multisearch", bytes.NewReader(reqBody))
+		if err != nil {
+			t.Errorf("Unexpected error: TestMultiSearch(%v)", err)
+		}
+		w := httptest.NewRecorder()
+		MultiSearch(w, r)
+		if w.Code != http.StatusOK {
+			t.Errorf("TestMultiSearch: %v, wanted: %v", w.Code, http.StatusOK)
+		}
+
+		var res model.MultiSearchResponse
+		if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+			t.Errorf("Unexpected error: TestMultiSearch(%v)", err)
+		}
+		if len(res.Results) != len(want) {
+			t.Fatalf("TestMultiSearch: %v, wanted: %d results", res.Results, len(want))
+		}
+		for i, id := range want {
+			r := res.Results[i]
+			if id == "" {
+				if r.Error == "" {
+					t.Errorf("TestMultiSearch(%d): %v, wanted: error", i, r)
+				}
+			} else if r.Error != "" || len(r.Result) != 1 || r.Result[0].ID != id {
+				t.Errorf("TestMultiSearch(%d): %v, wanted: %v", i, r, id)
+			}
+		}
+	})
+
 	t.Run("TestInsert", func(t *testing.T) {
 		defer SetupWithTeardown(t)()
 		tests := []struct {
@@ -337,4 +382,74 @@ func TestHTTP(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("TestListIDs", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		tests := []struct {
+			query string
+			want  []string
+		}{
+			{"", []string{"a", "b", "c", "d", "e", "f"}},
+			{"limit=4", []string{"a", "b", "c", "d", "e", "f"}},
+			{"limit=1&prefix=c", []string{"c"}},
+		}
+
+		for _, tt := range tests {
+			got := []string{}
+			cursor := ""
+			for i := 0; i < 10; i++ {
+				r, err := http.NewRequest(http.MethodGet, "/ids?"+tt.query+"&cursor="+cursor, http.NoBody)
+				if err != nil {
+					t.Errorf("Unexpected error: TestListIDs(%v)", err)
+				}
+				w := httptest.NewRecorder()
+				ListIDs(w, r)
+				if w.Code != http.StatusOK {
+					t.Errorf("TestListIDs(%v): status %v, wanted: 200", tt.query, w.Code)
+					break
+				}
+				var res model.ListIDsResponse
+				if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+					t.Errorf("Unexpected error: TestListIDs(%v)", err)
+				}
+				got = append(got, res.IDs...)
+				if res.Next == "" {
+					break
+				}
+				cursor = res.Next
+			}
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Errorf("TestListIDs(%v): %v, wanted: %v", tt.query, got, tt.want)
+			}
+		}
+
+		r, err := http.NewRequest(http.MethodGet, "/ids?limit=-1", http.NoBody)
+		if err != nil {
+			t.Errorf("Unexpected error: TestListIDs(%v)", err)
+		}
+		w := httptest.NewRecorder()
+		ListIDs(w, r)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("TestListIDs(limit=-1): status %v, wanted: 400", w.Code)
+		}
+	})
+	t.Run("TestSnapshot", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		r, err := http.NewRequest(http.MethodPost, "/snapshot", http.NoBody)
+		if err != nil {
+			t.Errorf("Unexpected error: TestSnapshot(%v)", err)
+		}
+		w := httptest.NewRecorder()
+		Snapshot(w, r)
+		if ct := w.Header().Get("Content-Type"); w.Code != http.StatusOK || ct != "application/gzip" {
+			t.Errorf("TestSnapshot: status %v, %v, wanted: 200, application/gzip", w.Code, ct)
+		}
+
+		r = mux.SetURLVars(r, map[string]string{"collection": "foo"})
+		w = httptest.NewRecorder()
+		Snapshot(w, r)
+		if ct := w.Header().Get("Content-Type"); w.Code != http.StatusNotFound || ct != "application/json; charset=utf-8" {
+			t.Errorf("TestSnapshot(foo): status %v, %v, wanted: 404, application/json", w.Code, ct)
+		}
+	})
 }
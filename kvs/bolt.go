@@ -17,16 +17,17 @@
 package kvs
 
 import (
+	"bytes"
+	"context"
 	"errors"
 
-	"fmt"
-
 	"github.com/boltdb/bolt"
 )
 
 var (
-	kvBoltBucketName = []byte("kv")
-	vkBoltBucketName = []byte("vk")
+	kvBoltBucketName   = []byte("kv")
+	vkBoltBucketName   = []byte("vk")
+	metaBoltBucketName = []byte("meta")
 )
 
 // BoltDB is one implementation of KVS
@@ -47,6 +48,9 @@ func NewBoltDB(p string) (*BoltDB, error) {
 		if _, err := tx.CreateBucketIfNotExists(vkBoltBucketName); err != nil {
 			return errors.New("cannot create bucket")
 		}
+		if _, err := tx.CreateBucketIfNotExists(metaBoltBucketName); err != nil {
+			return errors.New("cannot create bucket")
+		}
 		return nil
 	})
 	return &BoltDB{
@@ -88,12 +92,12 @@ func (b *BoltDB) GetKeys(vals []uint) ([][]byte, error) {
 
 func (b *BoltDB) GetVal(key []byte) (uint, error) {
 	val, err := b.get(kvBoltBucketName, key)
-	if len(val) != 4 {
-		return 0, fmt.Errorf("key not found: %v", key)
-	}
 	if err != nil {
 		return 0, err
 	}
+	if len(val) != 4 {
+		return 0, ErrNotFound
+	}
 
 	return ToInt(val), nil
 }
@@ -134,9 +138,97 @@ func (b *BoltDB) Delete(key []byte) error {
 	if err := b.del(vkBoltBucketName, ToBytes(val)); err != nil {
 		return err
 	}
+	if err := b.del(metaBoltBucketName, key); err != nil {
+		return err
+	}
 	return b.del(kvBoltBucketName, key)
 }
 
+// SetMeta stores metadata of the key
+func (b *BoltDB) SetMeta(key, meta []byte) error {
+	return b.set(metaBoltBucketName, key, meta)
+}
+
+// GetMeta returns metadata of the key. It returns nil if the key has no metadata.
+func (b *BoltDB) GetMeta(key []byte) ([]byte, error) {
+	meta, err := b.get(metaBoltBucketName, key)
+	if err != nil || meta == nil {
+		return nil, err
+	}
+	// bolt values are only valid during the transaction
+	ret := make([]byte, len(meta))
+	copy(ret, meta)
+	return ret, nil
+}
+
+// Range calls f for each key in key order until f returns false.
+func (b *BoltDB) Range(f func(key []byte, val uint) bool) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(kvBoltBucketName)
+		if bucket == nil {
+			return errors.New("BoltDB Bucket NotFound")
+		}
+		c := bucket.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			// bolt keys are only valid during the transaction
+			key := make([]byte, len(k))
+			copy(key, k)
+			if !f(key, ToInt(v)) {
+				return nil
+			}
+		}
+		return nil
+	})
+}
+
+// Scan returns keys in key order. The cursor is the key the next page starts from.
+func (b *BoltDB) Scan(cursor, prefix []byte, limit int) ([][]byte, []byte, error) {
+	var (
+		keys [][]byte
+		next []byte
+	)
+	err := b.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(kvBoltBucketName)
+		if bucket == nil {
+			return errors.New("BoltDB Bucket NotFound")
+		}
+		c := bucket.Cursor()
+		for k, _ := c.Seek(seekKey(cursor, prefix)); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			// bolt keys are only valid during the transaction
+			key := make([]byte, len(k))
+			copy(key, k)
+			if len(keys) == limit {
+				next = key
+				break
+			}
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, next, nil
+}
+
+func (b *BoltDB) Count() (int, error) {
+	var n int
+	err := b.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(kvBoltBucketName)
+		if bucket == nil {
+			return errors.New("BoltDB Bucket NotFound")
+		}
+		n = bucket.Stats().KeyN
+		return nil
+	})
+	return n, err
+}
+
+// WithContext checks ctx before each call, since BoltDB calls are not canceled in the middle.
+func (b *BoltDB) WithContext(ctx context.Context) KVS {
+	return BindContext(ctx, b)
+}
+
 func (b *BoltDB) Close() error {
 	return b.db.Close()
 }
@@ -56,6 +56,36 @@ func TestBolt(t *testing.T) {
 		os.RemoveAll("test")
 	})
 
+	t.Run("TestMeta", func(t *testing.T) {
+		b := initBolt(t)
+		defer SetupWithTeardown(b, t)()
+		Meta(b, t)
+	})
+
+	t.Run("TestRange", func(t *testing.T) {
+		b := initBolt(t)
+		defer SetupWithTeardown(b, t)()
+		Range(b, t)
+	})
+
+	t.Run("TestScan", func(t *testing.T) {
+		b := initBolt(t)
+		defer SetupWithTeardown(b, t)()
+		Scan(b, t)
+	})
+
+	t.Run("TestCount", func(t *testing.T) {
+		b := initBolt(t)
+		defer SetupWithTeardown(b, t)()
+		Count(b, t)
+	})
+
+	t.Run("TestWithContext", func(t *testing.T) {
+		b := initBolt(t)
+		defer SetupWithTeardown(b, t)()
+		WithContext(b, t)
+	})
+
 	t.Run("TestDelete", func(t *testing.T) {
 		b := initBolt(t)
 		defer SetupWithTeardown(b, t)()
@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package kvs
+
+import (
+	"context"
+)
+
+// contextKVS fails each call with the error of ctx once it is done.
+type contextKVS struct {
+	db  KVS
+	ctx context.Context
+}
+
+// BindContext returns db whose calls fail with the error of ctx once ctx is done.
+// It is WithContext of KVS whose calls cannot be stopped in the middle.
+func BindContext(ctx context.Context, db KVS) KVS {
+	if c, ok := db.(*contextKVS); ok {
+		db = c.db
+	}
+	return &contextKVS{db: db, ctx: ctx}
+}
+
+func (c *contextKVS) GetKey(val uint) ([]byte, error) {
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.db.GetKey(val)
+}
+
+func (c *contextKVS) GetKeys(vals []uint) ([][]byte, error) {
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.db.GetKeys(vals)
+}
+
+func (c *contextKVS) GetVal(key []byte) (uint, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.db.GetVal(key)
+}
+
+func (c *contextKVS) Set(key []byte, val uint) error {
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+	return c.db.Set(key, val)
+}
+
+func (c *contextKVS) Delete(key []byte) error {
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+	return c.db.Delete(key)
+}
+
+func (c *contextKVS) SetMeta(key, meta []byte) error {
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+	return c.db.SetMeta(key, meta)
+}
+
+func (c *contextKVS) GetMeta(key []byte) ([]byte, error) {
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.db.GetMeta(key)
+}
+
+// Range also stops between keys once ctx is done.
+func (c *contextKVS) Range(f func(key []byte, val uint) bool) error {
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+	var err error
+	rerr := c.db.Range(func(key []byte, val uint) bool {
+		if err = c.ctx.Err(); err != nil {
+			return false
+		}
+		return f(key, val)
+	})
+	if err != nil {
+		return err
+	}
+	return rerr
+}
+
+func (c *contextKVS) Scan(cursor, prefix []byte, limit int) ([][]byte, []byte, error) {
+	if err := c.ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	return c.db.Scan(cursor, prefix, limit)
+}
+
+func (c *contextKVS) Count() (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.db.Count()
+}
+
+func (c *contextKVS) WithContext(ctx context.Context) KVS {
+	return BindContext(ctx, c.db)
+}
+
+// Close closes the underlying KVS regardless of ctx.
+func (c *contextKVS) Close() error {
+	return c.db.Close()
+}
@@ -17,22 +17,48 @@
 package kvs
 
 import (
+	"bytes"
+	"context"
 	"encoding/binary"
+	"errors"
 )
 
 type KVS interface {
 	GetKey(uint) ([]byte, error)
 	GetKeys([]uint) ([][]byte, error)
+	// GetVal returns ErrNotFound if the key is not stored.
 	GetVal([]byte) (uint, error)
 	Set([]byte, uint) error
 	Delete([]byte) error
+	SetMeta([]byte, []byte) error
+	GetMeta([]byte) ([]byte, error)
+	// Range calls f for each key and its value until f returns false.
+	Range(f func(key []byte, val uint) bool) error
+	// Scan returns up to limit keys with prefix from cursor, and the cursor of the next page.
+	// An empty cursor starts from the beginning, and an empty next cursor means the end.
+	Scan(cursor, prefix []byte, limit int) (keys [][]byte, next []byte, err error)
+	// Count returns the number of keys.
+	Count() (int, error)
+	// WithContext returns the KVS whose calls stop with the error of ctx once ctx is done.
+	WithContext(ctx context.Context) KVS
 	Close() error
 }
 
 var (
+	// ErrNotFound is returned by GetVal for a key which is not stored.
+	ErrNotFound = errors.New("key not found")
+
 	byteOrder = binary.LittleEndian
 )
 
+// seekKey returns the first key to visit for cursor and prefix in ordered KVS.
+func seekKey(cursor, prefix []byte) []byte {
+	if bytes.Compare(cursor, prefix) > 0 {
+		return cursor
+	}
+	return prefix
+}
+
 // ToBytes convert integer to byte array
 func ToBytes(i uint) []byte {
 	key := make([]byte, 4)
@@ -17,9 +17,11 @@
 package kvs
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"reflect"
+	"sort"
 	"testing"
 )
 
@@ -135,6 +137,9 @@ func GetVal(db KVS, t *testing.T) {
 			t.Errorf("TestGetVal(%v): %v, wanted: %v", tt.key, val, tt.val)
 		}
 	}
+	if _, err := db.GetVal([]byte("piyo")); err != ErrNotFound {
+		t.Errorf("TestGetVal(piyo): %v, wanted: %v", err, ErrNotFound)
+	}
 }
 
 func Set(db KVS, t *testing.T) {
@@ -157,16 +162,160 @@ func Set(db KVS, t *testing.T) {
 func Delete(db KVS, t *testing.T) {
 	tests := []struct {
 		key []byte
+		val uint
 	}{
-		{[]byte("foo")},
-		{[]byte("bar")},
-		{[]byte("hoge")},
-		{[]byte("huga")},
+		{[]byte("foo"), 1},
+		{[]byte("bar"), 2},
+		{[]byte("hoge"), 3},
+		{[]byte("huga"), 4},
 	}
 	for _, tt := range tests {
 		if err := db.Delete(tt.key); err != nil {
 			t.Errorf("Unexpected error: TestDelete(%v) %v", tt, err)
 		}
+		// both directions of the pair are removed
+		if _, err := db.GetVal(tt.key); err != ErrNotFound {
+			t.Errorf("TestDelete(%s): key still exists after Delete(%v)", tt.key, err)
+		}
+		if key, err := db.GetKey(tt.val); err == nil && key != nil {
+			t.Errorf("TestDelete(%s): value %d still maps to %s after Delete", tt.key, tt.val, key)
+		}
+	}
+}
+
+func Meta(db KVS, t *testing.T) {
+	tests := []struct {
+		key  []byte
+		meta []byte
+	}{
+		{[]byte("foo"), []byte(`{"title":"foo"}`)},
+		{[]byte("bar"), []byte("bar")},
+	}
+	for _, tt := range tests {
+		if err := db.SetMeta(tt.key, tt.meta); err != nil {
+			t.Errorf("Unexpected error: TestMeta(%v) %v", tt, err)
+		}
+		meta, err := db.GetMeta(tt.key)
+		if err != nil {
+			t.Errorf("Unexpected error: TestMeta(%v) %v", tt, err)
+		}
+		if !reflect.DeepEqual(tt.meta, meta) {
+			t.Errorf("TestMeta(%v): %v, wanted: %v", tt.key, meta, tt.meta)
+		}
+	}
+
+	meta, err := db.GetMeta([]byte("hoge"))
+	if err != nil || meta != nil {
+		t.Errorf("TestMeta(hoge): %v, %v, wanted: nil, nil", meta, err)
+	}
+
+	if err := db.Delete([]byte("foo")); err != nil {
+		t.Errorf("Unexpected error: TestMeta(%v)", err)
+	}
+	if _, err := db.GetVal([]byte("foo")); err == nil {
+		t.Error("TestMeta: key foo still exists after Delete")
+	}
+	meta, err = db.GetMeta([]byte("foo"))
+	if err != nil || meta != nil {
+		t.Errorf("TestMeta(foo): %v, %v, wanted: nil, nil after Delete", meta, err)
+	}
+}
+
+func Range(db KVS, t *testing.T) {
+	want := map[string]uint{
+		"foo":  1,
+		"bar":  2,
+		"hoge": 3,
+		"huga": 4,
+	}
+	got := make(map[string]uint)
+	if err := db.Range(func(key []byte, val uint) bool {
+		got[string(key)] = val
+		return true
+	}); err != nil {
+		t.Errorf("Unexpected error: TestRange(%v)", err)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("TestRange(%v): %v, wanted: %v", k, got[k], v)
+		}
+	}
+
+	n := 0
+	if err := db.Range(func(key []byte, val uint) bool {
+		n++
+		return false
+	}); err != nil {
+		t.Errorf("Unexpected error: TestRange(%v)", err)
+	}
+	if n != 1 {
+		t.Errorf("TestRange: f is called %v times after it returns false, wanted: 1", n)
+	}
+}
+
+func Scan(db KVS, t *testing.T) {
+	tests := []struct {
+		prefix []byte
+		limit  int
+		want   []string
+	}{
+		{nil, 1, []string{"bar", "foo", "hoge", "huga"}},
+		{nil, 3, []string{"bar", "foo", "hoge", "huga"}},
+		{[]byte("h"), 1, []string{"hoge", "huga"}},
+		{[]byte("x"), 10, []string{}},
+	}
+	for _, tt := range tests {
+		got := make([]string, 0)
+		var cursor []byte
+		for i := 0; i < 100; i++ {
+			keys, next, err := db.Scan(cursor, tt.prefix, tt.limit)
+			if err != nil {
+				t.Errorf("Unexpected error: TestScan(%v)", err)
+				break
+			}
+			for _, k := range keys {
+				got = append(got, string(k))
+			}
+			if len(next) == 0 {
+				break
+			}
+			cursor = next
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("TestScan(%s, %v): %v, wanted: %v", tt.prefix, tt.limit, got, tt.want)
+		}
+	}
+}
+
+func Count(db KVS, t *testing.T) {
+	n, err := db.Count()
+	if err != nil {
+		t.Errorf("Unexpected error: TestCount(%v)", err)
+	}
+	if n != 4 {
+		t.Errorf("TestCount: %v, wanted: 4", n)
+	}
+}
+
+func WithContext(db KVS, t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := db.WithContext(ctx)
+	if n, err := c.Count(); err != nil || n != 4 {
+		t.Errorf("TestWithContext: %v, %v, wanted: 4", n, err)
+	}
+	cancel()
+	if _, err := c.GetVal([]byte("a")); err != context.Canceled {
+		t.Errorf("TestWithContext(GetVal): %v, wanted: %v", err, context.Canceled)
+	}
+	if err := c.Set([]byte("e"), 5); err != context.Canceled {
+		t.Errorf("TestWithContext(Set): %v, wanted: %v", err, context.Canceled)
+	}
+	if err := c.Range(func([]byte, uint) bool { return true }); err != context.Canceled {
+		t.Errorf("TestWithContext(Range): %v, wanted: %v", err, context.Canceled)
+	}
+	if n, err := db.Count(); err != nil || n != 4 {
+		t.Errorf("TestWithContext: %v, %v, wanted: 4 without context", n, err)
 	}
 }
 
@@ -17,14 +17,17 @@
 package kvs
 
 import (
+	"context"
 	"path"
 
 	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 type GoLevel struct {
-	kv *leveldb.DB
-	vk *leveldb.DB
+	kv   *leveldb.DB
+	vk   *leveldb.DB
+	meta *leveldb.DB
 }
 
 func NewGoLevel(p string) (*GoLevel, error) {
@@ -34,12 +37,20 @@ func NewGoLevel(p string) (*GoLevel, error) {
 	}
 	vk, err := leveldb.OpenFile(path.Join(p, "vk"), nil)
 	if err != nil {
+		kv.Close()
+		return nil, err
+	}
+	meta, err := leveldb.OpenFile(path.Join(p, "meta"), nil)
+	if err != nil {
+		kv.Close()
+		vk.Close()
 		return nil, err
 	}
 
 	return &GoLevel{
-		kv: kv,
-		vk: vk,
+		kv:   kv,
+		vk:   vk,
+		meta: meta,
 	}, err
 }
 
@@ -62,7 +73,9 @@ func (g *GoLevel) GetKeys(vals []uint) ([][]byte, error) {
 
 func (g *GoLevel) GetVal(key []byte) (uint, error) {
 	val, err := g.kv.Get(key, nil)
-	if err != nil {
+	if err == leveldb.ErrNotFound {
+		return 0, ErrNotFound
+	} else if err != nil {
 		return 0, err
 	}
 	return ToInt(val), nil
@@ -81,15 +94,90 @@ func (g *GoLevel) Delete(key []byte) error {
 	if err != nil {
 		return err
 	}
-	if err := g.kv.Delete(ToBytes(val), nil); err != nil {
+	if err := g.vk.Delete(ToBytes(val), nil); err != nil {
 		return err
 	}
-	return g.vk.Delete(key, nil)
+	if err := g.meta.Delete(key, nil); err != nil {
+		return err
+	}
+	return g.kv.Delete(key, nil)
+}
+
+// SetMeta stores metadata of the key
+func (g *GoLevel) SetMeta(key, meta []byte) error {
+	return g.meta.Put(key, meta, nil)
+}
+
+// GetMeta returns metadata of the key. It returns nil if the key has no metadata.
+func (g *GoLevel) GetMeta(key []byte) ([]byte, error) {
+	meta, err := g.meta.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	}
+	return meta, err
+}
+
+// Range calls f for each key in key order until f returns false.
+func (g *GoLevel) Range(f func(key []byte, val uint) bool) error {
+	it := g.kv.NewIterator(nil, nil)
+	defer it.Release()
+	for it.Next() {
+		// the iterator reuses its buffers
+		key := make([]byte, len(it.Key()))
+		copy(key, it.Key())
+		if !f(key, ToInt(it.Value())) {
+			break
+		}
+	}
+	return it.Error()
+}
+
+// Scan returns keys in key order. The cursor is the key the next page starts from.
+func (g *GoLevel) Scan(cursor, prefix []byte, limit int) ([][]byte, []byte, error) {
+	it := g.kv.NewIterator(util.BytesPrefix(prefix), nil)
+	defer it.Release()
+	var (
+		keys [][]byte
+		next []byte
+	)
+	for ok := it.Seek(seekKey(cursor, prefix)); ok; ok = it.Next() {
+		// the iterator reuses its buffers
+		key := make([]byte, len(it.Key()))
+		copy(key, it.Key())
+		if len(keys) == limit {
+			next = key
+			break
+		}
+		keys = append(keys, key)
+	}
+	if err := it.Error(); err != nil {
+		return nil, nil, err
+	}
+	return keys, next, nil
+}
+
+// Count iterates all keys, since leveldb does not keep the number of them.
+func (g *GoLevel) Count() (int, error) {
+	it := g.kv.NewIterator(nil, nil)
+	defer it.Release()
+	n := 0
+	for it.Next() {
+		n++
+	}
+	return n, it.Error()
+}
+
+// WithContext checks ctx before each call, since GoLevel calls are not canceled in the middle.
+func (g *GoLevel) WithContext(ctx context.Context) KVS {
+	return BindContext(ctx, g)
 }
 
 func (g *GoLevel) Close() error {
 	if err := g.kv.Close(); err != nil {
 		return err
 	}
-	return g.vk.Close()
+	if err := g.vk.Close(); err != nil {
+		return err
+	}
+	return g.meta.Close()
 }
@@ -19,6 +19,7 @@ package kvs
 import (
 	"os"
 	"io/ioutil"
+	"path"
 	"testing"
 )
 
@@ -61,12 +62,64 @@ func TestGoLevel(t *testing.T) {
 		os.RemoveAll("test")
 	})
 
+	t.Run("TestMeta", func(t *testing.T) {
+		g := initGoLevel(t)
+		defer SetupWithTeardown(g, t)()
+		Meta(g, t)
+	})
+
+	t.Run("TestRange", func(t *testing.T) {
+		g := initGoLevel(t)
+		defer SetupWithTeardown(g, t)()
+		Range(g, t)
+	})
+
+	t.Run("TestScan", func(t *testing.T) {
+		g := initGoLevel(t)
+		defer SetupWithTeardown(g, t)()
+		Scan(g, t)
+	})
+
+	t.Run("TestCount", func(t *testing.T) {
+		g := initGoLevel(t)
+		defer SetupWithTeardown(g, t)()
+		Count(g, t)
+	})
+
+	t.Run("TestWithContext", func(t *testing.T) {
+		g := initGoLevel(t)
+		defer SetupWithTeardown(g, t)()
+		WithContext(g, t)
+	})
+
 	t.Run("TestDelete", func(t *testing.T) {
 		g := initGoLevel(t)
 		defer SetupWithTeardown(g, t)()
 		Delete(g, t)
 	})
 
+	t.Run("TestOpenFailure", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", dbpath)
+		if err != nil {
+			t.Fatalf("Unexpected error: TestOpenFailure(%v)", err)
+		}
+		defer os.RemoveAll(dir)
+		// meta cannot be opened on a file, after kv and vk are opened
+		if err := ioutil.WriteFile(path.Join(dir, "meta"), nil, 0644); err != nil {
+			t.Fatalf("Unexpected error: TestOpenFailure(%v)", err)
+		}
+		if _, err := NewGoLevel(dir); err == nil {
+			t.Fatal("TestOpenFailure: nil, wanted: error")
+		}
+		os.Remove(path.Join(dir, "meta"))
+		// kv and vk are locked until they are closed
+		g, err := NewGoLevel(dir)
+		if err != nil {
+			t.Fatalf("Unexpected error: TestOpenFailure(%v)", err)
+		}
+		g.Close()
+	})
+
 	t.Run("TestClose", func(t *testing.T) {
 		g := initGoLevel(t)
 		defer SetupWithTeardown(g, t)()
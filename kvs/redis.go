@@ -17,8 +17,10 @@
 package kvs
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis"
@@ -26,6 +28,8 @@ import (
 
 const (
 	base = 36
+	// scanCount is the number of keys Range asks Redis for at once.
+	scanCount = 1000
 )
 
 func toRedisVal(v uint) string {
@@ -41,6 +45,9 @@ type Redis struct {
 	client *redis.Client
 	kv     int
 	vk     int
+	meta   int
+	// ctx stops the calls between round trips. nil means never.
+	ctx context.Context
 }
 
 func loopPing(client *redis.Client, timeout, retryFreq time.Duration) error {
@@ -67,9 +74,9 @@ func loopPing(client *redis.Client, timeout, retryFreq time.Duration) error {
 }
 
 // NewRedis initializes Redis
-func NewRedis(host, port, pass string, kv, vk int, pingTimeout, pingRetryFreq time.Duration) (*Redis, error) {
-	if kv == vk {
-		return nil, fmt.Errorf("kv and vk must be defferent. (%d, %d)", kv, vk)
+func NewRedis(host, port, pass string, kv, vk, meta int, pingTimeout, pingRetryFreq time.Duration) (*Redis, error) {
+	if kv == vk || kv == meta || vk == meta {
+		return nil, fmt.Errorf("kv, vk and meta must be defferent. (%d, %d, %d)", kv, vk, meta)
 	}
 	client := redis.NewClient(&redis.Options{
 		Addr:     fmt.Sprintf("%s:%s", host, port),
@@ -84,6 +91,7 @@ func NewRedis(host, port, pass string, kv, vk int, pingTimeout, pingRetryFreq ti
 		client: client,
 		kv:     kv,
 		vk:     vk,
+		meta:   meta,
 	}, nil
 }
 
@@ -91,7 +99,7 @@ func (r *Redis) GetKey(val uint) ([]byte, error) {
 	pipe := r.client.TxPipeline()
 	pipe.Select(r.vk)
 	key := pipe.Get(toRedisVal(val))
-	if _, err := pipe.Exec(); err != nil {
+	if _, err := r.exec(pipe); err != nil {
 		return nil, err
 	}
 	return key.Bytes()
@@ -107,7 +115,7 @@ func (r *Redis) GetKeys(vals []uint) ([][]byte, error) {
 	pipe := r.client.TxPipeline()
 	pipe.Select(r.vk)
 	keys := pipe.MGet(strVals...)
-	if _, err := pipe.Exec(); err != nil {
+	if _, err := r.exec(pipe); err != nil {
 		return nil, err
 	}
 	response, err := keys.Result()
@@ -127,7 +135,9 @@ func (r *Redis) GetVal(key []byte) (uint, error) {
 	pipe := r.client.TxPipeline()
 	pipe.Select(r.kv)
 	val := pipe.Get(string(key))
-	if _, err := pipe.Exec(); err != nil {
+	if _, err := r.exec(pipe); err == redis.Nil {
+		return 0, ErrNotFound
+	} else if err != nil {
 		return 0, err
 	}
 	return fromRedisVal(val.Val())
@@ -140,7 +150,7 @@ func (r *Redis) Set(key []byte, val uint) error {
 	kv := pipe.Set(string(key), v, 0)
 	pipe.Select(r.vk)
 	vk := pipe.Set(v, key, 0)
-	if _, err := pipe.Exec(); err != nil {
+	if _, err := r.exec(pipe); err != nil {
 		return err
 	}
 	if err := kv.Err(); err != nil {
@@ -159,13 +169,171 @@ func (r *Redis) Delete(key []byte) error {
 	kv := pipe.Del(string(key))
 	pipe.Select(r.vk)
 	vk := pipe.Del(toRedisVal(val))
-	if _, err := pipe.Exec(); err != nil {
+	pipe.Select(r.meta)
+	meta := pipe.Del(string(key))
+	if _, err := r.exec(pipe); err != nil {
 		return err
 	}
 	if err := kv.Err(); err != nil {
 		return err
 	}
-	return vk.Err()
+	if err := vk.Err(); err != nil {
+		return err
+	}
+	return meta.Err()
+}
+
+// SetMeta stores metadata of the key
+func (r *Redis) SetMeta(key, meta []byte) error {
+	pipe := r.client.TxPipeline()
+	pipe.Select(r.meta)
+	res := pipe.Set(string(key), meta, 0)
+	if _, err := r.exec(pipe); err != nil {
+		return err
+	}
+	return res.Err()
+}
+
+// GetMeta returns metadata of the key. It returns nil if the key has no metadata.
+func (r *Redis) GetMeta(key []byte) ([]byte, error) {
+	pipe := r.client.TxPipeline()
+	pipe.Select(r.meta)
+	meta := pipe.Get(string(key))
+	if _, err := r.exec(pipe); err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return meta.Bytes()
+}
+
+// Range calls f for each key until f returns false.
+// The order is unspecified and a key may be visited more than once, as SCAN of Redis does.
+func (r *Redis) Range(f func(key []byte, val uint) bool) error {
+	var cursor uint64
+	for {
+		pipe := r.client.TxPipeline()
+		pipe.Select(r.kv)
+		scan := pipe.Scan(cursor, "", scanCount)
+		if _, err := r.exec(pipe); err != nil {
+			return err
+		}
+		keys, next, err := scan.Result()
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			pipe := r.client.TxPipeline()
+			pipe.Select(r.kv)
+			vals := pipe.MGet(keys...)
+			if _, err := r.exec(pipe); err != nil {
+				return err
+			}
+			for i, v := range vals.Val() {
+				// the key is deleted after SCAN
+				s, ok := v.(string)
+				if !ok {
+					continue
+				}
+				val, err := fromRedisVal(s)
+				if err != nil {
+					return err
+				}
+				if !f([]byte(keys[i]), val) {
+					return nil
+				}
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// Scan returns keys in the order of SCAN of Redis, whose cursor is a number.
+// A page may have more or fewer than limit keys, since limit is only a hint for Redis.
+func (r *Redis) Scan(cursor, prefix []byte, limit int) ([][]byte, []byte, error) {
+	var c uint64
+	if len(cursor) > 0 {
+		var err error
+		c, err = strconv.ParseUint(string(cursor), 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid cursor: %s", cursor)
+		}
+	}
+	match := ""
+	if len(prefix) > 0 {
+		match = escapePattern(string(prefix)) + "*"
+	}
+	for {
+		pipe := r.client.TxPipeline()
+		pipe.Select(r.kv)
+		scan := pipe.Scan(c, match, int64(limit))
+		if _, err := r.exec(pipe); err != nil {
+			return nil, nil, err
+		}
+		keys, next, err := scan.Result()
+		if err != nil {
+			return nil, nil, err
+		}
+		// SCAN with MATCH may return empty pages in the middle
+		if len(keys) == 0 && next != 0 {
+			c = next
+			continue
+		}
+		ret := make([][]byte, len(keys))
+		for i, k := range keys {
+			ret[i] = []byte(k)
+		}
+		if next == 0 {
+			return ret, nil, nil
+		}
+		return ret, []byte(strconv.FormatUint(next, 10)), nil
+	}
+}
+
+func (r *Redis) Count() (int, error) {
+	pipe := r.client.TxPipeline()
+	pipe.Select(r.kv)
+	n := pipe.DBSize()
+	if _, err := r.exec(pipe); err != nil {
+		return 0, err
+	}
+	return int(n.Val()), nil
+}
+
+// escapePattern escapes the special characters of glob-style patterns of Redis.
+func escapePattern(s string) string {
+	var b strings.Builder
+	// keys are binary, so a byte which is not valid UTF-8 must be kept as it is
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '*', '?', '[', ']', '\\':
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// WithContext returns Redis sharing the connections, whose calls fail with the error of ctx
+// before each round trip once ctx is done. A round trip in flight is bounded by the timeouts of the client.
+func (r *Redis) WithContext(ctx context.Context) KVS {
+	ret := *r
+	ret.ctx = ctx
+	return &ret
+}
+
+// exec runs pipe unless ctx is done.
+func (r *Redis) exec(pipe redis.Pipeliner) ([]redis.Cmder, error) {
+	if r.ctx != nil {
+		if err := r.ctx.Err(); err != nil {
+			pipe.Close()
+			return nil, err
+		}
+	}
+	return pipe.Exec()
 }
 
 func (r *Redis) Close() error {
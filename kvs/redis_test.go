@@ -23,7 +23,7 @@ import (
 )
 
 func initRedis(t *testing.T) *Redis {
-	r, err := NewRedis("127.0.0.1", "6379", "", 0, 1, time.Second, time.Second*300)
+	r, err := NewRedis("127.0.0.1", "6379", "", 0, 1, 2, time.Second, time.Second*300)
 	if err != nil {
 		t.Fatalf("Unexpected Error: initRedis(): %v", err)
 	}
@@ -57,6 +57,36 @@ func TestRedis(t *testing.T) {
 		os.RemoveAll("test")
 	})
 
+	t.Run("TestMeta", func(t *testing.T) {
+		r := initRedis(t)
+		defer SetupWithTeardown(r, t)()
+		Meta(r, t)
+	})
+
+	t.Run("TestRange", func(t *testing.T) {
+		r := initRedis(t)
+		defer SetupWithTeardown(r, t)()
+		Range(r, t)
+	})
+
+	t.Run("TestScan", func(t *testing.T) {
+		r := initRedis(t)
+		defer SetupWithTeardown(r, t)()
+		Scan(r, t)
+	})
+
+	t.Run("TestCount", func(t *testing.T) {
+		r := initRedis(t)
+		defer SetupWithTeardown(r, t)()
+		Count(r, t)
+	})
+
+	t.Run("TestWithContext", func(t *testing.T) {
+		r := initRedis(t)
+		defer SetupWithTeardown(r, t)()
+		WithContext(r, t)
+	})
+
 	t.Run("TestDelete", func(t *testing.T) {
 		r := initRedis(t)
 		defer SetupWithTeardown(r, t)()
@@ -69,3 +99,19 @@ func TestRedis(t *testing.T) {
 		Close(r, t)
 	})
 }
+
+func TestEscapePattern(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"foo", "foo"},
+		{"a*b?[c]\\", "a\\*b\\?\\[c\\]\\\\"},
+		{"\xff\xfe*", "\xff\xfe\\*"},
+	}
+	for _, tt := range tests {
+		if got := escapePattern(tt.s); got != tt.want {
+			t.Errorf("TestEscapePattern(%q): %q, wanted: %q", tt.s, got, tt.want)
+		}
+	}
+}
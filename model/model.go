@@ -16,32 +16,109 @@
 
 package model
 
+import (
+	"encoding/json"
+	"time"
+)
+
 type SearchRequest struct {
-	Vector  []float64 `json:"vector"`
-	ID      string    `json:"id"`
-	Size    int       `json:"size"`
-	Epsilon float32   `json:"epsilon"`
+	Vector   []float64 `json:"vector"`
+	ID       string    `json:"id"`
+	Size     int       `json:"size"`
+	Epsilon  float32   `json:"epsilon"`
+	WithMeta bool      `json:"with_meta"`
+	// Filter is an attribute predicate on metadata, e.g. `category == "shoes" AND price < 100`.
+	Filter        string `json:"filter"`
+	MaxCandidates int    `json:"max_candidates"`
+	// Radius is the maximum distance of results. 0 keeps only exact matches and nil means unlimited.
+	// Range searches require it.
+	Radius *float32 `json:"radius"`
+	// ExcludeSelf drops the query object from the results of searches by ID,
+	// and the objects in the expression from the results of /searchbyexpression.
+	ExcludeSelf bool     `json:"exclude_self"`
+	ExcludeIDs  []string `json:"exclude_ids"`
+	// RerankCandidates approximate neighbors are re-ranked by exact distances under RerankMetric if it is greater than 0.
+	RerankCandidates int    `json:"rerank_candidates"`
+	RerankMetric     string `json:"rerank_metric"`
+	WithStats        bool   `json:"with_stats"`
+	// ScoreMode is distance, similarity or inverse. Empty means the server default.
+	ScoreMode string `json:"score_mode"`
+	// Positive and Negative are the examples of /searchbyexamples.
+	Positive []Example `json:"positive,omitempty"`
+	Negative []Example `json:"negative,omitempty"`
+	// Terms is the expression of /searchbyexpression.
+	Terms []Term `json:"terms,omitempty"`
+}
+
+// Term is the vector of ID or a literal Vector multiplied by Weight. Weight 0 means 1.
+type Term struct {
+	ID     string    `json:"id,omitempty"`
+	Vector []float64 `json:"vector,omitempty"`
+	Weight float32   `json:"weight"`
+}
+
+// Example is a stored object given to /searchbyexamples. Weight 0 means 1.
+type Example struct {
+	ID     string  `json:"id"`
+	Weight float32 `json:"weight"`
 }
 
 type SearchResult struct {
-	ID       string  `json:"id"`
-	Distance float32 `json:"distance"`
+	ID       string          `json:"id"`
+	Distance float32         `json:"distance"`
+	Score    *float32        `json:"score,omitempty"`
+	Meta     json.RawMessage `json:"meta,omitempty"`
 }
 
 type SearchResponse struct {
 	Result []SearchResult `json:"result"`
 	Errors []error        `json:"errors"`
+	Stats  *SearchStats   `json:"stats,omitempty"`
+	// ScoreMode is the score mode applied to the results.
+	ScoreMode string `json:"score_mode"`
+	// Truncated is set if a range search stopped at max_candidates and more objects may be within the radius.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// SearchStats reports the time of each stage in milliseconds.
+type SearchStats struct {
+	SearchMS   float64 `json:"search_ms"`
+	RerankMS   float64 `json:"rerank_ms"`
+	TotalMS    float64 `json:"total_ms"`
+	Candidates int     `json:"candidates"`
+}
+
+type MultiSearchRequest struct {
+	SearchRequests []SearchRequest `json:"search_requests"`
+}
+
+// MultiSearchResult is the result of one query. Error is set instead of Result if the query failed.
+type MultiSearchResult struct {
+	Result    []SearchResult `json:"result"`
+	Error     string         `json:"error,omitempty"`
+	Stats     *SearchStats   `json:"stats,omitempty"`
+	ScoreMode string         `json:"score_mode,omitempty"`
+}
+
+type MultiSearchResponse struct {
+	Results []MultiSearchResult `json:"results"`
 }
 
 type InsertRequest struct {
-	Vector []float64 `json:"vector"`
-	ID     string    `json:"id"`
+	Vector []float64       `json:"vector"`
+	ID     string          `json:"id"`
+	Meta   json.RawMessage `json:"meta,omitempty"`
 }
 
 type InsertResponse struct {
 	Status string `json:"status"`
 }
 
+type UpsertResponse struct {
+	Status   string `json:"status"`
+	Replaced bool   `json:"replaced"`
+}
+
 type MultiInsertRequest struct {
 	InsertRequests []InsertRequest `json:"insert_requests"`
 }
@@ -69,8 +146,9 @@ type GetObjectsRequest struct {
 }
 
 type GetObjectResult struct {
-	ID     string    `json:"id"`
-	Vector []float32 `json:"vector"`
+	ID     string          `json:"id"`
+	Vector []float32       `json:"vector"`
+	Meta   json.RawMessage `json:"meta,omitempty"`
 }
 
 type GetObjectsResponse struct {
@@ -89,3 +167,120 @@ type DefaultResponse struct {
 	Code    int    `json:"code"`
 	Error   error  `json:"error"`
 }
+
+type Collection struct {
+	Name         string `json:"name"`
+	Dimension    int    `json:"dimension"`
+	DistanceType string `json:"distance_type"`
+	ObjectType   string `json:"object_type"`
+	Normalize    bool   `json:"normalize"`
+}
+
+type ListCollectionsResponse struct {
+	Collections []Collection `json:"collections"`
+}
+
+// ListIDsResponse is a page of IDs. Next is the cursor of the next page, which is empty on the last page.
+type ListIDsResponse struct {
+	IDs  []string `json:"ids"`
+	Next string   `json:"next,omitempty"`
+}
+
+type CountResponse struct {
+	Count int `json:"count"`
+}
+
+// StatsResponse reports the state of the index. Durations are in seconds and
+// the times are omitted until the index is created or saved for the first time.
+type StatsResponse struct {
+	Objects            int        `json:"objects"`
+	NGTObjects         int        `json:"ngt_objects"`
+	IndexedObjects     int        `json:"indexed_objects"`
+	UnindexedObjects   int        `json:"unindexed_objects"`
+	UnsavedObjects     int        `json:"unsaved_objects"`
+	LastCreateIndex    *time.Time `json:"last_create_index,omitempty"`
+	CreateIndexSeconds float64    `json:"create_index_seconds"`
+	LastSaveIndex      *time.Time `json:"last_save_index,omitempty"`
+	SaveIndexSeconds   float64    `json:"save_index_seconds"`
+	IndexSize          int64      `json:"index_size"`
+	KVSType            string     `json:"kvs_type"`
+	UptimeSeconds      float64    `json:"uptime_seconds"`
+	// AutoIndex is omitted if the background CreateIndex is disabled.
+	AutoIndex *AutoIndexResponse `json:"auto_index,omitempty"`
+	// AutoSave is omitted if the background SaveIndex is disabled.
+	AutoSave *AutoSaveResponse `json:"auto_save,omitempty"`
+}
+
+// AutoIndexResponse reports the policy and the last run of the background CreateIndex.
+// The pending objects are UnindexedObjects of StatsResponse.
+type AutoIndexResponse struct {
+	Pending         int        `json:"pending"`
+	IntervalSeconds float64    `json:"interval_seconds"`
+	PoolSize        int        `json:"pool_size"`
+	Runs            int        `json:"runs"`
+	LastRun         *time.Time `json:"last_run,omitempty"`
+	LastRunSeconds  float64    `json:"last_run_seconds"`
+	LastError       string     `json:"last_error,omitempty"`
+}
+
+// AutoSaveResponse reports the policy and the last run of the background SaveIndex.
+type AutoSaveResponse struct {
+	Unsaved         int        `json:"unsaved"`
+	IntervalSeconds float64    `json:"interval_seconds"`
+	Runs            int        `json:"runs"`
+	LastRun         *time.Time `json:"last_run,omitempty"`
+	LastRunSeconds  float64    `json:"last_run_seconds"`
+	LastError       string     `json:"last_error,omitempty"`
+}
+
+// HealthResponse reports whether the index can keep serving without losing data.
+// Status is "ok", or "unhealthy" with the problems in Errors.
+type HealthResponse struct {
+	Status         string            `json:"status"`
+	Errors         []string          `json:"errors,omitempty"`
+	LastSaveIndex  *time.Time        `json:"last_save_index,omitempty"`
+	UnsavedObjects int               `json:"unsaved_objects"`
+	AutoSave       *AutoSaveResponse `json:"auto_save,omitempty"`
+}
+
+type DuplicatesRequest struct {
+	// Threshold is the maximum distance of duplicate pairs.
+	Threshold float32 `json:"threshold"`
+	Epsilon   float32 `json:"epsilon"`
+}
+
+type DuplicatePair struct {
+	ID1      string  `json:"id1"`
+	ID2      string  `json:"id2"`
+	Distance float32 `json:"distance"`
+}
+
+// DuplicateCluster is a group of objects connected by duplicate pairs.
+type DuplicateCluster struct {
+	IDs         []string `json:"ids"`
+	MaxDistance float32  `json:"max_distance"`
+}
+
+// JobResponse reports the progress of a job, and its result once it is done.
+type JobResponse struct {
+	ID       string             `json:"id"`
+	Kind     string             `json:"kind"`
+	State    string             `json:"state"`
+	Done     int                `json:"done"`
+	Total    int                `json:"total"`
+	Error    string             `json:"error,omitempty"`
+	Started  time.Time          `json:"started"`
+	Finished *time.Time         `json:"finished,omitempty"`
+	Pairs    []DuplicatePair    `json:"pairs,omitempty"`
+	Clusters []DuplicateCluster `json:"clusters,omitempty"`
+}
+
+type ListJobsResponse struct {
+	Jobs []JobResponse `json:"jobs"`
+}
+
+// ReloadRequest names the index and the KVS to swap in. Empty ones are the paths the server started with.
+type ReloadRequest struct {
+	Index        string `json:"index"`
+	DatabasePath string `json:"database_path"`
+}
@@ -44,6 +44,7 @@ import (
 type NGTD struct {
 	sigCh   chan os.Signal
 	l       net.Listener
+	index   string
 	port    string
 	running bool
 }
@@ -72,6 +73,14 @@ func NewNGTD(index string, db kvs.KVS, port int) (*NGTD, error) {
 		return nil, fmt.Errorf("%v", errs)
 	}
 
+	if err := service.OpenWAL(service.WALPath(index)); err != nil {
+		return nil, err
+	}
+
+	if err := service.OpenJournal(service.JournalPath(index)); err != nil {
+		return nil, err
+	}
+
 	p := strconv.Itoa(port)
 
 	l, err := net.Listen("tcp", ":"+p)
@@ -82,10 +91,36 @@ func NewNGTD(index string, db kvs.KVS, port int) (*NGTD, error) {
 	return &NGTD{
 		sigCh: sigCh,
 		l:     l,
+		index: index,
 		port:  p,
 	}, nil
 }
 
+// OpenCollections enables named collections stored under root.
+// newDB opens the KVS of each collection at the given path.
+func (n *NGTD) OpenCollections(root string, newDB func(string) (kvs.KVS, error)) error {
+	return service.OpenCollections(root, newDB)
+}
+
+// EnableReload lets the reload API and SIGHUP swap the default index and KVS for others while serving.
+// SIGHUP reloads the paths the server started with, which may be symbolic links switched to a new build.
+// db is the path of the KVS served now, and newDB opens the KVS at the given path.
+func (n *NGTD) EnableReload(db string, newDB func(string) (kvs.KVS, error)) error {
+	if err := service.EnableReload(n.index, db, newDB); err != nil {
+		return err
+	}
+	hupCh := make(chan os.Signal, 1)
+	signal.Notify(hupCh, syscall.SIGHUP)
+	go func() {
+		for range hupCh {
+			if err := service.Reload("", ""); err != nil {
+				glg.Errorf("cannot reload: %v", err)
+			}
+		}
+	}()
+	return nil
+}
+
 func (n *NGTD) ListenAndServe(t ServerType) error {
 	switch t {
 	case HTTP:
@@ -100,7 +135,9 @@ func (n *NGTD) listenAndServeHTTP() error {
 	if n.running {
 		return ErrServerAlreadyRunning
 	}
-	defer gongt.Close()
+	// the index served last, which may have been reloaded
+	defer service.Close()
+	defer service.CloseCollections()
 	srv := &http.Server{
 		Addr:    ":" + n.port,
 		Handler: router.NewRouter(),
@@ -121,11 +158,11 @@ func (n *NGTD) listenAndServeHTTP() error {
 	defer cancel()
 	glg.Info("NGTD HTTP API Server Shutdown ...")
 
-	if err := srv.Shutdown(ctx); err != nil {
-		return err
-	}
+	err := srv.Shutdown(ctx)
+	// no request is running anymore, so the indexes are saved as the last writes left them
+	service.Shutdown()
 
-	return nil
+	return err
 }
 
 func (n *NGTD) listenAndServeGRPC() error {
@@ -133,7 +170,9 @@ func (n *NGTD) listenAndServeGRPC() error {
 		return ErrServerAlreadyRunning
 	}
 
-	defer gongt.Close()
+	// the index served last, which may have been reloaded
+	defer service.Close()
+	defer service.CloseCollections()
 	srv := grpc.NewServer()
 	pb.RegisterNGTDServer(srv, &handler.GRPC{})
 
@@ -148,10 +187,29 @@ func (n *NGTD) listenAndServeGRPC() error {
 
 	// wait terminate signal
 	<-n.sigCh
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
 	glg.Info("NGTD GRPC Server Shutdown ...")
-	srv.Stop()
 
-	return nil
+	// GracefulStop waits for the running RPCs, and returns once Stop closes them at the timeout
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+	var err error
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		err = ctx.Err()
+		srv.Stop()
+		<-stopped
+	}
+	// no request is running anymore, so the indexes are saved as the last writes left them
+	service.Shutdown()
+
+	return err
 }
 
 func (n *NGTD) ListenAndServeProfile(port int) error {
@@ -16,28 +16,51 @@
 
 package ngtdtest
 
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/yahoojapan/ngtd/kvs"
+)
+
+// Map is KVS on memory. It is safe for concurrent use like the other KVS.
 type Map struct {
-	kv map[string]uint
-	vk map[uint][]byte
+	mu   sync.RWMutex
+	kv   map[string]uint
+	vk   map[uint][]byte
+	meta map[string][]byte
 }
 
 func NewMap() *Map {
 	return &Map{
-		kv: make(map[string]uint),
-		vk: make(map[uint][]byte),
+		kv:   make(map[string]uint),
+		vk:   make(map[uint][]byte),
+		meta: make(map[string][]byte),
 	}
 }
 
 func (m *Map) GetVal(key []byte) (uint, error) {
-	return m.kv[string(key)], nil
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	val, ok := m.kv[string(key)]
+	if !ok {
+		return 0, kvs.ErrNotFound
+	}
+	return val, nil
 }
 
 func (m *Map) GetKey(val uint) ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	return []byte(m.vk[val]), nil
 }
 
 // GetKeys returns keys corresponding to vals
 func (m *Map) GetKeys(vals []uint) ([][]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	result := make([][]byte, len(vals))
 	for i, val := range vals {
 		result[i] = []byte(m.vk[val])
@@ -46,19 +69,90 @@ func (m *Map) GetKeys(vals []uint) ([][]byte, error) {
 }
 
 func (m *Map) Set(key []byte, val uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.kv[string(key)] = val
 	m.vk[val] = key
 	return nil
 }
 
 func (m *Map) Delete(key []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	k := string(key)
 	v := m.kv[k]
 	delete(m.kv, k)
 	delete(m.vk, v)
+	delete(m.meta, k)
+	return nil
+}
+
+func (m *Map) SetMeta(key, meta []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.meta[string(key)] = meta
+	return nil
+}
+
+func (m *Map) GetMeta(key []byte) ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.meta[string(key)], nil
+}
+
+// Range calls f for each key in key order until f returns false.
+// It visits the keys at the time of the call, so that f may call the other methods.
+func (m *Map) Range(f func(key []byte, val uint) bool) error {
+	m.mu.RLock()
+	keys := make([]string, 0, len(m.kv))
+	vals := make(map[string]uint, len(m.kv))
+	for k, v := range m.kv {
+		keys = append(keys, k)
+		vals[k] = v
+	}
+	m.mu.RUnlock()
+	sort.Strings(keys)
+	for _, k := range keys {
+		if !f([]byte(k), vals[k]) {
+			break
+		}
+	}
 	return nil
 }
 
+// Scan returns keys in key order. The cursor is the key the next page starts from.
+func (m *Map) Scan(cursor, prefix []byte, limit int) ([][]byte, []byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	keys := make([]string, 0, len(m.kv))
+	for k := range m.kv {
+		if strings.HasPrefix(k, string(prefix)) && k >= string(cursor) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var next []byte
+	if len(keys) > limit {
+		next = []byte(keys[limit])
+		keys = keys[:limit]
+	}
+	ret := make([][]byte, len(keys))
+	for i, k := range keys {
+		ret[i] = []byte(k)
+	}
+	return ret, next, nil
+}
+
+func (m *Map) Count() (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.kv), nil
+}
+
+func (m *Map) WithContext(ctx context.Context) kvs.KVS {
+	return kvs.BindContext(ctx, m)
+}
+
 func (m *Map) Close() error {
 	return nil
 }
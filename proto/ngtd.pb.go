@@ -65,13 +65,28 @@ func (m *Empty) XXX_DiscardUnknown() {
 var xxx_messageInfo_Empty proto.InternalMessageInfo
 
 type SearchRequest struct {
-	Vector               []float64 `protobuf:"fixed64,1,rep,packed,name=vector,proto3" json:"vector,omitempty"`
-	Id                   []byte    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Size_                int32     `protobuf:"varint,10,opt,name=size,proto3" json:"size,omitempty"`
-	Epsilon              float32   `protobuf:"fixed32,11,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Vector               []float64  `protobuf:"fixed64,1,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	Id                   []byte     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Collection           string     `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	WithMeta             bool       `protobuf:"varint,4,opt,name=with_meta,json=withMeta,proto3" json:"with_meta,omitempty"`
+	Filter               string     `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	MaxCandidates        int32      `protobuf:"varint,6,opt,name=max_candidates,json=maxCandidates,proto3" json:"max_candidates,omitempty"`
+	Radius               float32    `protobuf:"fixed32,7,opt,name=radius,proto3" json:"radius,omitempty"`
+	ExcludeSelf          bool       `protobuf:"varint,8,opt,name=exclude_self,json=excludeSelf,proto3" json:"exclude_self,omitempty"`
+	ExcludeIds           [][]byte   `protobuf:"bytes,9,rep,name=exclude_ids,json=excludeIds,proto3" json:"exclude_ids,omitempty"`
+	Size_                int32      `protobuf:"varint,10,opt,name=size,proto3" json:"size,omitempty"`
+	Epsilon              float32    `protobuf:"fixed32,11,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
+	RerankCandidates     int32      `protobuf:"varint,12,opt,name=rerank_candidates,json=rerankCandidates,proto3" json:"rerank_candidates,omitempty"`
+	RerankMetric         string     `protobuf:"bytes,13,opt,name=rerank_metric,json=rerankMetric,proto3" json:"rerank_metric,omitempty"`
+	WithStats            bool       `protobuf:"varint,14,opt,name=with_stats,json=withStats,proto3" json:"with_stats,omitempty"`
+	ScoreMode            string     `protobuf:"bytes,15,opt,name=score_mode,json=scoreMode,proto3" json:"score_mode,omitempty"`
+	Positive             []*Example `protobuf:"bytes,16,rep,name=positive,proto3" json:"positive,omitempty"`
+	Negative             []*Example `protobuf:"bytes,17,rep,name=negative,proto3" json:"negative,omitempty"`
+	Terms                []*Term    `protobuf:"bytes,18,rep,name=terms,proto3" json:"terms,omitempty"`
+	HasRadius            bool       `protobuf:"varint,19,opt,name=has_radius,json=hasRadius,proto3" json:"has_radius,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
@@ -121,158 +136,146 @@ func (m *SearchRequest) GetId() []byte {
 	return nil
 }
 
-func (m *SearchRequest) GetSize_() int32 {
+func (m *SearchRequest) GetCollection() string {
 	if m != nil {
-		return m.Size_
+		return m.Collection
 	}
-	return 0
+	return ""
 }
 
-func (m *SearchRequest) GetEpsilon() float32 {
+func (m *SearchRequest) GetWithMeta() bool {
 	if m != nil {
-		return m.Epsilon
+		return m.WithMeta
 	}
-	return 0
+	return false
 }
 
-type ObjectDistance struct {
-	Id                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Distance             float32  `protobuf:"fixed32,10,opt,name=distance,proto3" json:"distance,omitempty"`
-	Error                string   `protobuf:"bytes,99,opt,name=error,proto3" json:"error,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SearchRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
 }
 
-func (m *ObjectDistance) Reset()         { *m = ObjectDistance{} }
-func (m *ObjectDistance) String() string { return proto.CompactTextString(m) }
-func (*ObjectDistance) ProtoMessage()    {}
-func (*ObjectDistance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2a3ceaadf6e6af, []int{2}
-}
-func (m *ObjectDistance) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ObjectDistance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ObjectDistance.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *SearchRequest) GetMaxCandidates() int32 {
+	if m != nil {
+		return m.MaxCandidates
 	}
+	return 0
 }
-func (m *ObjectDistance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ObjectDistance.Merge(m, src)
-}
-func (m *ObjectDistance) XXX_Size() int {
-	return m.Size()
-}
-func (m *ObjectDistance) XXX_DiscardUnknown() {
-	xxx_messageInfo_ObjectDistance.DiscardUnknown(m)
+
+func (m *SearchRequest) GetRadius() float32 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
 }
 
-var xxx_messageInfo_ObjectDistance proto.InternalMessageInfo
+func (m *SearchRequest) GetExcludeSelf() bool {
+	if m != nil {
+		return m.ExcludeSelf
+	}
+	return false
+}
 
-func (m *ObjectDistance) GetId() []byte {
+func (m *SearchRequest) GetExcludeIds() [][]byte {
 	if m != nil {
-		return m.Id
+		return m.ExcludeIds
 	}
 	return nil
 }
 
-func (m *ObjectDistance) GetDistance() float32 {
+func (m *SearchRequest) GetSize_() int32 {
 	if m != nil {
-		return m.Distance
+		return m.Size_
 	}
 	return 0
 }
 
-func (m *ObjectDistance) GetError() string {
+func (m *SearchRequest) GetEpsilon() float32 {
 	if m != nil {
-		return m.Error
+		return m.Epsilon
 	}
-	return ""
+	return 0
 }
 
-type SearchResponse struct {
-	Result               []*ObjectDistance `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
-	Error                string            `protobuf:"bytes,99,opt,name=error,proto3" json:"error,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *SearchRequest) GetRerankCandidates() int32 {
+	if m != nil {
+		return m.RerankCandidates
+	}
+	return 0
 }
 
-func (m *SearchResponse) Reset()         { *m = SearchResponse{} }
-func (m *SearchResponse) String() string { return proto.CompactTextString(m) }
-func (*SearchResponse) ProtoMessage()    {}
-func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2a3ceaadf6e6af, []int{3}
-}
-func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SearchResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *SearchRequest) GetRerankMetric() string {
+	if m != nil {
+		return m.RerankMetric
 	}
+	return ""
 }
-func (m *SearchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchResponse.Merge(m, src)
+
+func (m *SearchRequest) GetWithStats() bool {
+	if m != nil {
+		return m.WithStats
+	}
+	return false
 }
-func (m *SearchResponse) XXX_Size() int {
-	return m.Size()
+
+func (m *SearchRequest) GetScoreMode() string {
+	if m != nil {
+		return m.ScoreMode
+	}
+	return ""
 }
-func (m *SearchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchResponse.DiscardUnknown(m)
+
+func (m *SearchRequest) GetPositive() []*Example {
+	if m != nil {
+		return m.Positive
+	}
+	return nil
 }
 
-var xxx_messageInfo_SearchResponse proto.InternalMessageInfo
+func (m *SearchRequest) GetNegative() []*Example {
+	if m != nil {
+		return m.Negative
+	}
+	return nil
+}
 
-func (m *SearchResponse) GetResult() []*ObjectDistance {
+func (m *SearchRequest) GetTerms() []*Term {
 	if m != nil {
-		return m.Result
+		return m.Terms
 	}
 	return nil
 }
 
-func (m *SearchResponse) GetError() string {
+func (m *SearchRequest) GetHasRadius() bool {
 	if m != nil {
-		return m.Error
+		return m.HasRadius
 	}
-	return ""
+	return false
 }
 
-type InsertRequest struct {
-	Vector               []float64 `protobuf:"fixed64,1,rep,packed,name=vector,proto3" json:"vector,omitempty"`
-	Id                   []byte    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+type Term struct {
+	Id                   []byte    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Vector               []float64 `protobuf:"fixed64,2,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	Weight               float32   `protobuf:"fixed32,3,opt,name=weight,proto3" json:"weight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
-func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
-func (*InsertRequest) ProtoMessage()    {}
-func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2a3ceaadf6e6af, []int{4}
+func (m *Term) Reset()         { *m = Term{} }
+func (m *Term) String() string { return proto.CompactTextString(m) }
+func (*Term) ProtoMessage()    {}
+func (*Term) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{2}
 }
-func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
+func (m *Term) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Term) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InsertRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Term.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -282,51 +285,59 @@ func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *InsertRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertRequest.Merge(m, src)
+func (m *Term) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Term.Merge(m, src)
 }
-func (m *InsertRequest) XXX_Size() int {
+func (m *Term) XXX_Size() int {
 	return m.Size()
 }
-func (m *InsertRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertRequest.DiscardUnknown(m)
+func (m *Term) XXX_DiscardUnknown() {
+	xxx_messageInfo_Term.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertRequest proto.InternalMessageInfo
+var xxx_messageInfo_Term proto.InternalMessageInfo
 
-func (m *InsertRequest) GetVector() []float64 {
+func (m *Term) GetId() []byte {
 	if m != nil {
-		return m.Vector
+		return m.Id
 	}
 	return nil
 }
 
-func (m *InsertRequest) GetId() []byte {
+func (m *Term) GetVector() []float64 {
 	if m != nil {
-		return m.Id
+		return m.Vector
 	}
 	return nil
 }
 
-type InsertResponse struct {
-	Error                string   `protobuf:"bytes,99,opt,name=error,proto3" json:"error,omitempty"`
+func (m *Term) GetWeight() float32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type Example struct {
+	Id                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Weight               float32  `protobuf:"fixed32,2,opt,name=weight,proto3" json:"weight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InsertResponse) Reset()         { *m = InsertResponse{} }
-func (m *InsertResponse) String() string { return proto.CompactTextString(m) }
-func (*InsertResponse) ProtoMessage()    {}
-func (*InsertResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2a3ceaadf6e6af, []int{5}
+func (m *Example) Reset()         { *m = Example{} }
+func (m *Example) String() string { return proto.CompactTextString(m) }
+func (*Example) ProtoMessage()    {}
+func (*Example) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{3}
 }
-func (m *InsertResponse) XXX_Unmarshal(b []byte) error {
+func (m *Example) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InsertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Example) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InsertResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Example.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -336,44 +347,54 @@ func (m *InsertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *InsertResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertResponse.Merge(m, src)
+func (m *Example) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Example.Merge(m, src)
 }
-func (m *InsertResponse) XXX_Size() int {
+func (m *Example) XXX_Size() int {
 	return m.Size()
 }
-func (m *InsertResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertResponse.DiscardUnknown(m)
+func (m *Example) XXX_DiscardUnknown() {
+	xxx_messageInfo_Example.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertResponse proto.InternalMessageInfo
+var xxx_messageInfo_Example proto.InternalMessageInfo
 
-func (m *InsertResponse) GetError() string {
+func (m *Example) GetId() []byte {
 	if m != nil {
-		return m.Error
+		return m.Id
 	}
-	return ""
+	return nil
 }
 
-type RemoveRequest struct {
-	Id                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (m *Example) GetWeight() float32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type SearchStats struct {
+	SearchMs             float64  `protobuf:"fixed64,1,opt,name=search_ms,json=searchMs,proto3" json:"search_ms,omitempty"`
+	RerankMs             float64  `protobuf:"fixed64,2,opt,name=rerank_ms,json=rerankMs,proto3" json:"rerank_ms,omitempty"`
+	TotalMs              float64  `protobuf:"fixed64,3,opt,name=total_ms,json=totalMs,proto3" json:"total_ms,omitempty"`
+	Candidates           int32    `protobuf:"varint,4,opt,name=candidates,proto3" json:"candidates,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveRequest) Reset()         { *m = RemoveRequest{} }
-func (m *RemoveRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveRequest) ProtoMessage()    {}
-func (*RemoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2a3ceaadf6e6af, []int{6}
+func (m *SearchStats) Reset()         { *m = SearchStats{} }
+func (m *SearchStats) String() string { return proto.CompactTextString(m) }
+func (*SearchStats) ProtoMessage()    {}
+func (*SearchStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{4}
 }
-func (m *RemoveRequest) XXX_Unmarshal(b []byte) error {
+func (m *SearchStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SearchStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SearchStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -383,44 +404,69 @@ func (m *RemoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *RemoveRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveRequest.Merge(m, src)
+func (m *SearchStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchStats.Merge(m, src)
 }
-func (m *RemoveRequest) XXX_Size() int {
+func (m *SearchStats) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveRequest.DiscardUnknown(m)
+func (m *SearchStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveRequest proto.InternalMessageInfo
+var xxx_messageInfo_SearchStats proto.InternalMessageInfo
 
-func (m *RemoveRequest) GetId() []byte {
+func (m *SearchStats) GetSearchMs() float64 {
 	if m != nil {
-		return m.Id
+		return m.SearchMs
 	}
-	return nil
+	return 0
 }
 
-type RemoveResponse struct {
+func (m *SearchStats) GetRerankMs() float64 {
+	if m != nil {
+		return m.RerankMs
+	}
+	return 0
+}
+
+func (m *SearchStats) GetTotalMs() float64 {
+	if m != nil {
+		return m.TotalMs
+	}
+	return 0
+}
+
+func (m *SearchStats) GetCandidates() int32 {
+	if m != nil {
+		return m.Candidates
+	}
+	return 0
+}
+
+type ObjectDistance struct {
+	Id                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta                 []byte   `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Distance             float32  `protobuf:"fixed32,10,opt,name=distance,proto3" json:"distance,omitempty"`
+	Score                float32  `protobuf:"fixed32,11,opt,name=score,proto3" json:"score,omitempty"`
 	Error                string   `protobuf:"bytes,99,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveResponse) Reset()         { *m = RemoveResponse{} }
-func (m *RemoveResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveResponse) ProtoMessage()    {}
-func (*RemoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2a3ceaadf6e6af, []int{7}
+func (m *ObjectDistance) Reset()         { *m = ObjectDistance{} }
+func (m *ObjectDistance) String() string { return proto.CompactTextString(m) }
+func (*ObjectDistance) ProtoMessage()    {}
+func (*ObjectDistance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{5}
 }
-func (m *RemoveResponse) XXX_Unmarshal(b []byte) error {
+func (m *ObjectDistance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ObjectDistance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ObjectDistance.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -430,44 +476,77 @@ func (m *RemoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *RemoveResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveResponse.Merge(m, src)
+func (m *ObjectDistance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectDistance.Merge(m, src)
 }
-func (m *RemoveResponse) XXX_Size() int {
+func (m *ObjectDistance) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveResponse.DiscardUnknown(m)
+func (m *ObjectDistance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectDistance.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveResponse proto.InternalMessageInfo
+var xxx_messageInfo_ObjectDistance proto.InternalMessageInfo
 
-func (m *RemoveResponse) GetError() string {
+func (m *ObjectDistance) GetId() []byte {
 	if m != nil {
-		return m.Error
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ObjectDistance) GetMeta() []byte {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *ObjectDistance) GetDistance() float32 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *ObjectDistance) GetScore() float32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *ObjectDistance) GetError() string {
+	if m != nil {
+		return m.Error
 	}
 	return ""
 }
 
-type CreateIndexRequest struct {
-	PoolSize             uint32   `protobuf:"varint,1,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+type SearchResponse struct {
+	Result    []*ObjectDistance `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Stats     *SearchStats      `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	ScoreMode string            `protobuf:"bytes,3,opt,name=score_mode,json=scoreMode,proto3" json:"score_mode,omitempty"`
+	// truncated is set if a range search stopped at max_candidates and more objects may be within the radius.
+	Truncated            bool     `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	Error                string   `protobuf:"bytes,99,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
-func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateIndexRequest) ProtoMessage()    {}
-func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2a3ceaadf6e6af, []int{8}
+func (m *SearchResponse) Reset()         { *m = SearchResponse{} }
+func (m *SearchResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchResponse) ProtoMessage()    {}
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{6}
 }
-func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
+func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateIndexRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SearchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -477,44 +556,73 @@ func (m *CreateIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CreateIndexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateIndexRequest.Merge(m, src)
+func (m *SearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResponse.Merge(m, src)
 }
-func (m *CreateIndexRequest) XXX_Size() int {
+func (m *SearchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateIndexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateIndexRequest.DiscardUnknown(m)
+func (m *SearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateIndexRequest proto.InternalMessageInfo
+var xxx_messageInfo_SearchResponse proto.InternalMessageInfo
 
-func (m *CreateIndexRequest) GetPoolSize() uint32 {
+func (m *SearchResponse) GetResult() []*ObjectDistance {
 	if m != nil {
-		return m.PoolSize
+		return m.Result
 	}
-	return 0
+	return nil
 }
 
-type GetDimensionResponse struct {
-	Dimension            int32    `protobuf:"varint,1,opt,name=dimension,proto3" json:"dimension,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SearchResponse) GetStats() *SearchStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
 }
 
-func (m *GetDimensionResponse) Reset()         { *m = GetDimensionResponse{} }
-func (m *GetDimensionResponse) String() string { return proto.CompactTextString(m) }
-func (*GetDimensionResponse) ProtoMessage()    {}
-func (*GetDimensionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2a3ceaadf6e6af, []int{9}
+func (m *SearchResponse) GetScoreMode() string {
+	if m != nil {
+		return m.ScoreMode
+	}
+	return ""
 }
-func (m *GetDimensionResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *SearchResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+func (m *SearchResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type MultiSearchRequest struct {
+	Requests             []*SearchRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Collection           string           `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MultiSearchRequest) Reset()         { *m = MultiSearchRequest{} }
+func (m *MultiSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*MultiSearchRequest) ProtoMessage()    {}
+func (*MultiSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{7}
+}
+func (m *MultiSearchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetDimensionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetDimensionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiSearchRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -524,44 +632,51 @@ func (m *GetDimensionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *GetDimensionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDimensionResponse.Merge(m, src)
+func (m *MultiSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSearchRequest.Merge(m, src)
 }
-func (m *GetDimensionResponse) XXX_Size() int {
+func (m *MultiSearchRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetDimensionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDimensionResponse.DiscardUnknown(m)
+func (m *MultiSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSearchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDimensionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MultiSearchRequest proto.InternalMessageInfo
 
-func (m *GetDimensionResponse) GetDimension() int32 {
+func (m *MultiSearchRequest) GetRequests() []*SearchRequest {
 	if m != nil {
-		return m.Dimension
+		return m.Requests
 	}
-	return 0
+	return nil
 }
 
-type GetObjectRequest struct {
-	Id                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *MultiSearchRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
 }
 
-func (m *GetObjectRequest) Reset()         { *m = GetObjectRequest{} }
-func (m *GetObjectRequest) String() string { return proto.CompactTextString(m) }
-func (*GetObjectRequest) ProtoMessage()    {}
-func (*GetObjectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2a3ceaadf6e6af, []int{10}
+type MultiSearchResponse struct {
+	Responses            []*SearchResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *GetObjectRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *MultiSearchResponse) Reset()         { *m = MultiSearchResponse{} }
+func (m *MultiSearchResponse) String() string { return proto.CompactTextString(m) }
+func (*MultiSearchResponse) ProtoMessage()    {}
+func (*MultiSearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{8}
+}
+func (m *MultiSearchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiSearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetObjectRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiSearchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -571,46 +686,47 @@ func (m *GetObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *GetObjectRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetObjectRequest.Merge(m, src)
+func (m *MultiSearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSearchResponse.Merge(m, src)
 }
-func (m *GetObjectRequest) XXX_Size() int {
+func (m *MultiSearchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetObjectRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetObjectRequest.DiscardUnknown(m)
+func (m *MultiSearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSearchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetObjectRequest proto.InternalMessageInfo
+var xxx_messageInfo_MultiSearchResponse proto.InternalMessageInfo
 
-func (m *GetObjectRequest) GetId() []byte {
+func (m *MultiSearchResponse) GetResponses() []*SearchResponse {
 	if m != nil {
-		return m.Id
+		return m.Responses
 	}
 	return nil
 }
 
-type GetObjectResponse struct {
-	Id                   []byte    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Vector               []float32 `protobuf:"fixed32,2,rep,packed,name=vector,proto3" json:"vector,omitempty"`
-	Error                string    `protobuf:"bytes,99,opt,name=error,proto3" json:"error,omitempty"`
+type InsertRequest struct {
+	Vector               []float64 `protobuf:"fixed64,1,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	Id                   []byte    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Collection           string    `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	Meta                 []byte    `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *GetObjectResponse) Reset()         { *m = GetObjectResponse{} }
-func (m *GetObjectResponse) String() string { return proto.CompactTextString(m) }
-func (*GetObjectResponse) ProtoMessage()    {}
-func (*GetObjectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af2a3ceaadf6e6af, []int{11}
+func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
+func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertRequest) ProtoMessage()    {}
+func (*InsertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{9}
 }
-func (m *GetObjectResponse) XXX_Unmarshal(b []byte) error {
+func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetObjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetObjectResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InsertRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -620,1362 +736,8517 @@ func (m *GetObjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *GetObjectResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetObjectResponse.Merge(m, src)
+func (m *InsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertRequest.Merge(m, src)
 }
-func (m *GetObjectResponse) XXX_Size() int {
+func (m *InsertRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetObjectResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetObjectResponse.DiscardUnknown(m)
+func (m *InsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetObjectResponse proto.InternalMessageInfo
+var xxx_messageInfo_InsertRequest proto.InternalMessageInfo
 
-func (m *GetObjectResponse) GetId() []byte {
+func (m *InsertRequest) GetVector() []float64 {
 	if m != nil {
-		return m.Id
+		return m.Vector
 	}
 	return nil
 }
 
-func (m *GetObjectResponse) GetVector() []float32 {
+func (m *InsertRequest) GetId() []byte {
 	if m != nil {
-		return m.Vector
+		return m.Id
 	}
 	return nil
 }
 
-func (m *GetObjectResponse) GetError() string {
+func (m *InsertRequest) GetCollection() string {
 	if m != nil {
-		return m.Error
+		return m.Collection
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Empty)(nil), "ngtd.Empty")
-	proto.RegisterType((*SearchRequest)(nil), "ngtd.SearchRequest")
-	proto.RegisterType((*ObjectDistance)(nil), "ngtd.ObjectDistance")
-	proto.RegisterType((*SearchResponse)(nil), "ngtd.SearchResponse")
-	proto.RegisterType((*InsertRequest)(nil), "ngtd.InsertRequest")
-	proto.RegisterType((*InsertResponse)(nil), "ngtd.InsertResponse")
-	proto.RegisterType((*RemoveRequest)(nil), "ngtd.RemoveRequest")
-	proto.RegisterType((*RemoveResponse)(nil), "ngtd.RemoveResponse")
-	proto.RegisterType((*CreateIndexRequest)(nil), "ngtd.CreateIndexRequest")
-	proto.RegisterType((*GetDimensionResponse)(nil), "ngtd.GetDimensionResponse")
-	proto.RegisterType((*GetObjectRequest)(nil), "ngtd.GetObjectRequest")
-	proto.RegisterType((*GetObjectResponse)(nil), "ngtd.GetObjectResponse")
+func (m *InsertRequest) GetMeta() []byte {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("proto/ngtd.proto", fileDescriptor_af2a3ceaadf6e6af) }
-
-var fileDescriptor_af2a3ceaadf6e6af = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0xaf, 0xb3, 0x36, 0x5b, 0x5f, 0xff, 0x50, 0x4c, 0x35, 0xa2, 0x82, 0x4a, 0x94, 0x03, 0xe4,
-	0x80, 0x06, 0x0c, 0x06, 0xda, 0x05, 0xa4, 0xad, 0xa8, 0xf4, 0x02, 0xc2, 0xdd, 0x1d, 0x75, 0xcd,
-	0x13, 0x04, 0xb5, 0x71, 0x70, 0xbc, 0x8a, 0xed, 0x73, 0x70, 0xe0, 0x23, 0x71, 0xe4, 0x23, 0xa0,
-	0xf2, 0x45, 0x50, 0xed, 0x38, 0x4b, 0xda, 0x0d, 0xa9, 0xdd, 0xcd, 0x7e, 0x7e, 0xbf, 0x3f, 0x79,
-	0xfe, 0x39, 0xd0, 0x8a, 0x05, 0x97, 0xfc, 0x49, 0xf4, 0x59, 0x06, 0x7b, 0x6a, 0x49, 0xcb, 0x8b,
-	0xb5, 0xb7, 0x0d, 0x95, 0xb7, 0xd3, 0x58, 0x9e, 0x7b, 0x08, 0x8d, 0x21, 0x8e, 0xc4, 0xf8, 0x0b,
-	0xc3, 0x6f, 0x67, 0x98, 0x48, 0xba, 0x0b, 0xf6, 0x0c, 0xc7, 0x92, 0x0b, 0x87, 0xb8, 0x5b, 0x3e,
-	0x61, 0xe9, 0x8e, 0x36, 0xc1, 0x0a, 0x03, 0xc7, 0x72, 0x89, 0x5f, 0x67, 0x56, 0x18, 0x50, 0x0a,
-	0xe5, 0x24, 0xbc, 0x40, 0x07, 0x5c, 0xe2, 0x57, 0x98, 0x5a, 0x53, 0x07, 0xb6, 0x31, 0x4e, 0xc2,
-	0x09, 0x8f, 0x9c, 0x9a, 0x4b, 0x7c, 0x8b, 0x99, 0xad, 0xc7, 0xa0, 0xf9, 0xe1, 0xf4, 0x2b, 0x8e,
-	0x65, 0x2f, 0x4c, 0xe4, 0x28, 0x1a, 0x63, 0xca, 0x47, 0x32, 0xbe, 0x0e, 0xec, 0x04, 0xe9, 0x99,
-	0xe2, 0xb4, 0x58, 0xb6, 0xa7, 0x6d, 0xa8, 0xa0, 0x10, 0x5c, 0x38, 0x63, 0x97, 0xf8, 0x55, 0xa6,
-	0x37, 0xde, 0x09, 0x34, 0x8d, 0xf5, 0x24, 0xe6, 0x51, 0x82, 0xf4, 0x31, 0xd8, 0x02, 0x93, 0xb3,
-	0x89, 0x54, 0xde, 0x6b, 0xfb, 0xed, 0x3d, 0xf5, 0xe1, 0x45, 0x65, 0x96, 0xf6, 0x5c, 0xc3, 0xfa,
-	0x0a, 0x1a, 0x83, 0x28, 0x41, 0x21, 0xd7, 0x1c, 0x88, 0xf7, 0x10, 0x9a, 0x06, 0x98, 0xda, 0xb9,
-	0x5a, 0xe0, 0x01, 0x34, 0x18, 0x4e, 0xf9, 0x0c, 0x8d, 0xc0, 0xd2, 0x24, 0x16, 0x44, 0xa6, 0xe1,
-	0xbf, 0x44, 0xcf, 0x80, 0x1e, 0x0b, 0x1c, 0x49, 0x1c, 0x44, 0x01, 0x7e, 0x37, 0x6c, 0xf7, 0xa0,
-	0x1a, 0x73, 0x3e, 0xf9, 0xa4, 0x2e, 0x67, 0x41, 0xda, 0x60, 0x3b, 0x8b, 0xc2, 0x30, 0xbc, 0x40,
-	0xef, 0x05, 0xb4, 0xfb, 0x28, 0x7b, 0xe1, 0x14, 0xa3, 0x24, 0xe4, 0x51, 0x26, 0x70, 0x1f, 0xaa,
-	0x81, 0x29, 0x2a, 0x50, 0x85, 0x5d, 0x16, 0x3c, 0x0f, 0x5a, 0x7d, 0x94, 0x7a, 0x8a, 0xd7, 0x99,
-	0xfe, 0x08, 0xb7, 0x73, 0x3d, 0x29, 0xed, 0xf2, 0x1d, 0x5f, 0x8e, 0xd2, 0x72, 0xb7, 0x7c, 0x2b,
-	0x1b, 0xe5, 0x95, 0xdf, 0xb7, 0xff, 0xc3, 0x86, 0xf2, 0xfb, 0xfe, 0x49, 0x8f, 0x1e, 0x80, 0xad,
-	0x2f, 0x9a, 0xde, 0xd1, 0x17, 0x5a, 0x48, 0x6c, 0xa7, 0x5d, 0x2c, 0x6a, 0x6d, 0xaf, 0x44, 0x0f,
-	0x01, 0x74, 0xed, 0xe8, 0x7c, 0xd0, 0x5b, 0x0f, 0xfa, 0x06, 0xea, 0x43, 0x29, 0x70, 0x34, 0xdd,
-	0x40, 0xd7, 0x27, 0x4f, 0x09, 0x3d, 0x86, 0x56, 0x9e, 0x60, 0x6d, 0x07, 0x8a, 0xe4, 0x00, 0x6c,
-	0x9d, 0x28, 0x03, 0x2d, 0x04, 0xd3, 0x40, 0x8b, 0xa1, 0xcb, 0x9b, 0xdf, 0x00, 0x6c, 0x74, 0x75,
-	0x00, 0x0d, 0xb4, 0x90, 0x57, 0x03, 0x2d, 0x66, 0x34, 0xaf, 0xbb, 0x01, 0x58, 0xe9, 0xbe, 0x86,
-	0x6a, 0x96, 0x21, 0xba, 0xab, 0x1b, 0x97, 0x83, 0xd7, 0xb9, 0xbb, 0x52, 0xcf, 0x0c, 0xbc, 0x83,
-	0x5b, 0xda, 0xc0, 0x4d, 0x58, 0x94, 0x93, 0x97, 0x50, 0xcb, 0x3d, 0x2d, 0xea, 0xe8, 0xee, 0xd5,
-	0xd7, 0xd6, 0xa9, 0xe9, 0x13, 0xfd, 0x2f, 0x2d, 0xd1, 0x47, 0x50, 0x1d, 0x8e, 0x66, 0x29, 0x2a,
-	0x7f, 0xb6, 0xdc, 0x78, 0x08, 0xf5, 0xfc, 0x43, 0x2c, 0xf6, 0x76, 0x32, 0x73, 0x2b, 0x2f, 0xd5,
-	0x2b, 0x1d, 0xb5, 0x7e, 0xcd, 0xbb, 0xe4, 0xf7, 0xbc, 0x4b, 0xfe, 0xcc, 0xbb, 0xe4, 0xe7, 0xdf,
-	0x6e, 0xe9, 0xd4, 0x56, 0x7f, 0xf6, 0xe7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x86, 0xcd, 0x58,
-	0xa8, 0xed, 0x05, 0x00, 0x00,
+type InsertResponse struct {
+	Error                string   `protobuf:"bytes,99,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// NGTDClient is the client API for NGTD service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NGTDClient interface {
-	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
-	SearchByID(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
-	StreamSearch(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamSearchClient, error)
-	StreamSearchByID(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamSearchByIDClient, error)
-	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error)
-	StreamInsert(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamInsertClient, error)
-	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
-	StreamRemove(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamRemoveClient, error)
-	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error)
-	StreamGetObject(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamGetObjectClient, error)
-	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*Empty, error)
-	SaveIndex(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
-	GetDimension(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetDimensionResponse, error)
+func (m *InsertResponse) Reset()         { *m = InsertResponse{} }
+func (m *InsertResponse) String() string { return proto.CompactTextString(m) }
+func (*InsertResponse) ProtoMessage()    {}
+func (*InsertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{10}
 }
-
-type nGTDClient struct {
-	cc *grpc.ClientConn
+func (m *InsertResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewNGTDClient(cc *grpc.ClientConn) NGTDClient {
-	return &nGTDClient{cc}
+func (m *InsertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsertResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func (c *nGTDClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
-	out := new(SearchResponse)
-	err := c.cc.Invoke(ctx, "/ngtd.NGTD/Search", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *InsertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertResponse.Merge(m, src)
 }
-
-func (c *nGTDClient) SearchByID(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
-	out := new(SearchResponse)
-	err := c.cc.Invoke(ctx, "/ngtd.NGTD/SearchByID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *InsertResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *nGTDClient) StreamSearch(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamSearchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[0], "/ngtd.NGTD/StreamSearch", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &nGTDStreamSearchClient{stream}
-	return x, nil
+func (m *InsertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertResponse.DiscardUnknown(m)
 }
 
-type NGTD_StreamSearchClient interface {
-	Send(*SearchRequest) error
-	Recv() (*SearchResponse, error)
-	grpc.ClientStream
-}
+var xxx_messageInfo_InsertResponse proto.InternalMessageInfo
 
-type nGTDStreamSearchClient struct {
-	grpc.ClientStream
+func (m *InsertResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (x *nGTDStreamSearchClient) Send(m *SearchRequest) error {
-	return x.ClientStream.SendMsg(m)
+type UpsertResponse struct {
+	Replaced             bool     `protobuf:"varint,1,opt,name=replaced,proto3" json:"replaced,omitempty"`
+	Error                string   `protobuf:"bytes,99,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *nGTDStreamSearchClient) Recv() (*SearchResponse, error) {
-	m := new(SearchResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *UpsertResponse) Reset()         { *m = UpsertResponse{} }
+func (m *UpsertResponse) String() string { return proto.CompactTextString(m) }
+func (*UpsertResponse) ProtoMessage()    {}
+func (*UpsertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{11}
 }
-
-func (c *nGTDClient) StreamSearchByID(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamSearchByIDClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[1], "/ngtd.NGTD/StreamSearchByID", opts...)
-	if err != nil {
-		return nil, err
+func (m *UpsertResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &nGTDStreamSearchByIDClient{stream}
-	return x, nil
 }
-
-type NGTD_StreamSearchByIDClient interface {
-	Send(*SearchRequest) error
-	Recv() (*SearchResponse, error)
-	grpc.ClientStream
+func (m *UpsertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertResponse.Merge(m, src)
 }
-
-type nGTDStreamSearchByIDClient struct {
-	grpc.ClientStream
+func (m *UpsertResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *nGTDStreamSearchByIDClient) Send(m *SearchRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *UpsertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertResponse.DiscardUnknown(m)
 }
 
-func (x *nGTDStreamSearchByIDClient) Recv() (*SearchResponse, error) {
-	m := new(SearchResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
+var xxx_messageInfo_UpsertResponse proto.InternalMessageInfo
 
-func (c *nGTDClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error) {
-	out := new(InsertResponse)
-	err := c.cc.Invoke(ctx, "/ngtd.NGTD/Insert", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpsertResponse) GetReplaced() bool {
+	if m != nil {
+		return m.Replaced
 	}
-	return out, nil
+	return false
 }
 
-func (c *nGTDClient) StreamInsert(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamInsertClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[2], "/ngtd.NGTD/StreamInsert", opts...)
-	if err != nil {
-		return nil, err
+func (m *UpsertResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	x := &nGTDStreamInsertClient{stream}
-	return x, nil
+	return ""
 }
 
-type NGTD_StreamInsertClient interface {
-	Send(*InsertRequest) error
-	Recv() (*InsertResponse, error)
-	grpc.ClientStream
+type RemoveRequest struct {
+	Id                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Collection           string   `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type nGTDStreamInsertClient struct {
-	grpc.ClientStream
+func (m *RemoveRequest) Reset()         { *m = RemoveRequest{} }
+func (m *RemoveRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveRequest) ProtoMessage()    {}
+func (*RemoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{12}
 }
-
-func (x *nGTDStreamInsertClient) Send(m *InsertRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *RemoveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *nGTDStreamInsertClient) Recv() (*InsertResponse, error) {
-	m := new(InsertResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *RemoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-func (c *nGTDClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
-	out := new(RemoveResponse)
-	err := c.cc.Invoke(ctx, "/ngtd.NGTD/Remove", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RemoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveRequest.Merge(m, src)
 }
-
-func (c *nGTDClient) StreamRemove(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamRemoveClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[3], "/ngtd.NGTD/StreamRemove", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &nGTDStreamRemoveClient{stream}
-	return x, nil
+func (m *RemoveRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type NGTD_StreamRemoveClient interface {
-	Send(*RemoveRequest) error
-	Recv() (*RemoveResponse, error)
-	grpc.ClientStream
+func (m *RemoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveRequest.DiscardUnknown(m)
 }
 
-type nGTDStreamRemoveClient struct {
-	grpc.ClientStream
-}
+var xxx_messageInfo_RemoveRequest proto.InternalMessageInfo
 
-func (x *nGTDStreamRemoveClient) Send(m *RemoveRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *RemoveRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
 }
 
-func (x *nGTDStreamRemoveClient) Recv() (*RemoveResponse, error) {
-	m := new(RemoveResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *RemoveRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
 	}
-	return m, nil
+	return ""
 }
 
-func (c *nGTDClient) GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error) {
-	out := new(GetObjectResponse)
-	err := c.cc.Invoke(ctx, "/ngtd.NGTD/GetObject", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RemoveResponse struct {
+	Error                string   `protobuf:"bytes,99,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *nGTDClient) StreamGetObject(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamGetObjectClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[4], "/ngtd.NGTD/StreamGetObject", opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveResponse) Reset()         { *m = RemoveResponse{} }
+func (m *RemoveResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveResponse) ProtoMessage()    {}
+func (*RemoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{13}
+}
+func (m *RemoveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &nGTDStreamGetObjectClient{stream}
-	return x, nil
 }
-
-type NGTD_StreamGetObjectClient interface {
-	Send(*GetObjectRequest) error
-	Recv() (*GetObjectResponse, error)
-	grpc.ClientStream
+func (m *RemoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveResponse.Merge(m, src)
 }
-
-type nGTDStreamGetObjectClient struct {
-	grpc.ClientStream
+func (m *RemoveResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *nGTDStreamGetObjectClient) Send(m *GetObjectRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *RemoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveResponse.DiscardUnknown(m)
 }
 
-func (x *nGTDStreamGetObjectClient) Recv() (*GetObjectResponse, error) {
-	m := new(GetObjectResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
+var xxx_messageInfo_RemoveResponse proto.InternalMessageInfo
 
-func (c *nGTDClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ngtd.NGTD/CreateIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RemoveResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *nGTDClient) SaveIndex(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ngtd.NGTD/SaveIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CreateIndexRequest struct {
+	PoolSize             uint32   `protobuf:"varint,1,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	Collection           string   `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *nGTDClient) GetDimension(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetDimensionResponse, error) {
-	out := new(GetDimensionResponse)
-	err := c.cc.Invoke(ctx, "/ngtd.NGTD/GetDimension", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
+func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateIndexRequest) ProtoMessage()    {}
+func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{14}
+}
+func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// NGTDServer is the server API for NGTD service.
-type NGTDServer interface {
-	Search(context.Context, *SearchRequest) (*SearchResponse, error)
-	SearchByID(context.Context, *SearchRequest) (*SearchResponse, error)
-	StreamSearch(NGTD_StreamSearchServer) error
-	StreamSearchByID(NGTD_StreamSearchByIDServer) error
-	Insert(context.Context, *InsertRequest) (*InsertResponse, error)
-	StreamInsert(NGTD_StreamInsertServer) error
-	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
-	StreamRemove(NGTD_StreamRemoveServer) error
-	GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error)
-	StreamGetObject(NGTD_StreamGetObjectServer) error
-	CreateIndex(context.Context, *CreateIndexRequest) (*Empty, error)
-	SaveIndex(context.Context, *Empty) (*Empty, error)
-	GetDimension(context.Context, *Empty) (*GetDimensionResponse, error)
+func (m *CreateIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateIndexRequest.Merge(m, src)
 }
-
-func RegisterNGTDServer(s *grpc.Server, srv NGTDServer) {
-	s.RegisterService(&_NGTD_serviceDesc, srv)
+func (m *CreateIndexRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _NGTD_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NGTDServer).Search(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ngtd.NGTD/Search",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NGTDServer).Search(ctx, req.(*SearchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *CreateIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateIndexRequest.DiscardUnknown(m)
 }
 
-func _NGTD_SearchByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NGTDServer).SearchByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ngtd.NGTD/SearchByID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NGTDServer).SearchByID(ctx, req.(*SearchRequest))
+var xxx_messageInfo_CreateIndexRequest proto.InternalMessageInfo
+
+func (m *CreateIndexRequest) GetPoolSize() uint32 {
+	if m != nil {
+		return m.PoolSize
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _NGTD_StreamSearch_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(NGTDServer).StreamSearch(&nGTDStreamSearchServer{stream})
+func (m *CreateIndexRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
 }
 
-type NGTD_StreamSearchServer interface {
-	Send(*SearchResponse) error
-	Recv() (*SearchRequest, error)
-	grpc.ServerStream
+type CollectionRequest struct {
+	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type nGTDStreamSearchServer struct {
-	grpc.ServerStream
+func (m *CollectionRequest) Reset()         { *m = CollectionRequest{} }
+func (m *CollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*CollectionRequest) ProtoMessage()    {}
+func (*CollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{15}
 }
-
-func (x *nGTDStreamSearchServer) Send(m *SearchResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *CollectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *nGTDStreamSearchServer) Recv() (*SearchRequest, error) {
-	m := new(SearchRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *CollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-func _NGTD_StreamSearchByID_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(NGTDServer).StreamSearchByID(&nGTDStreamSearchByIDServer{stream})
+func (m *CollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionRequest.Merge(m, src)
 }
-
-type NGTD_StreamSearchByIDServer interface {
-	Send(*SearchResponse) error
-	Recv() (*SearchRequest, error)
-	grpc.ServerStream
+func (m *CollectionRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type nGTDStreamSearchByIDServer struct {
-	grpc.ServerStream
+func (m *CollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionRequest.DiscardUnknown(m)
 }
 
-func (x *nGTDStreamSearchByIDServer) Send(m *SearchResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
+var xxx_messageInfo_CollectionRequest proto.InternalMessageInfo
 
-func (x *nGTDStreamSearchByIDServer) Recv() (*SearchRequest, error) {
-	m := new(SearchRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *CollectionRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
 	}
-	return m, nil
+	return ""
 }
 
-func _NGTD_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NGTDServer).Insert(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ngtd.NGTD/Insert",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NGTDServer).Insert(ctx, req.(*InsertRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetDimensionResponse struct {
+	Dimension            int32    `protobuf:"varint,1,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _NGTD_StreamInsert_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(NGTDServer).StreamInsert(&nGTDStreamInsertServer{stream})
+func (m *GetDimensionResponse) Reset()         { *m = GetDimensionResponse{} }
+func (m *GetDimensionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDimensionResponse) ProtoMessage()    {}
+func (*GetDimensionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{16}
 }
-
-type NGTD_StreamInsertServer interface {
-	Send(*InsertResponse) error
-	Recv() (*InsertRequest, error)
-	grpc.ServerStream
+func (m *GetDimensionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type nGTDStreamInsertServer struct {
-	grpc.ServerStream
+func (m *GetDimensionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDimensionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func (x *nGTDStreamInsertServer) Send(m *InsertResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *GetDimensionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDimensionResponse.Merge(m, src)
 }
-
-func (x *nGTDStreamInsertServer) Recv() (*InsertRequest, error) {
-	m := new(InsertRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *GetDimensionResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _NGTD_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NGTDServer).Remove(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ngtd.NGTD/Remove",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NGTDServer).Remove(ctx, req.(*RemoveRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *GetDimensionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDimensionResponse.DiscardUnknown(m)
 }
 
-func _NGTD_StreamRemove_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(NGTDServer).StreamRemove(&nGTDStreamRemoveServer{stream})
-}
+var xxx_messageInfo_GetDimensionResponse proto.InternalMessageInfo
 
-type NGTD_StreamRemoveServer interface {
-	Send(*RemoveResponse) error
-	Recv() (*RemoveRequest, error)
-	grpc.ServerStream
+func (m *GetDimensionResponse) GetDimension() int32 {
+	if m != nil {
+		return m.Dimension
+	}
+	return 0
 }
 
-type nGTDStreamRemoveServer struct {
-	grpc.ServerStream
+type GetObjectRequest struct {
+	Id                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Collection           string   `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *nGTDStreamRemoveServer) Send(m *RemoveResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *GetObjectRequest) Reset()         { *m = GetObjectRequest{} }
+func (m *GetObjectRequest) String() string { return proto.CompactTextString(m) }
+func (*GetObjectRequest) ProtoMessage()    {}
+func (*GetObjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{17}
 }
-
-func (x *nGTDStreamRemoveServer) Recv() (*RemoveRequest, error) {
-	m := new(RemoveRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GetObjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetObjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *GetObjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetObjectRequest.Merge(m, src)
+}
+func (m *GetObjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetObjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetObjectRequest.DiscardUnknown(m)
 }
 
-func _NGTD_GetObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetObjectRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NGTDServer).GetObject(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ngtd.NGTD/GetObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NGTDServer).GetObject(ctx, req.(*GetObjectRequest))
+var xxx_messageInfo_GetObjectRequest proto.InternalMessageInfo
+
+func (m *GetObjectRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _NGTD_StreamGetObject_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(NGTDServer).StreamGetObject(&nGTDStreamGetObjectServer{stream})
+func (m *GetObjectRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
 }
 
-type NGTD_StreamGetObjectServer interface {
-	Send(*GetObjectResponse) error
-	Recv() (*GetObjectRequest, error)
-	grpc.ServerStream
+type GetObjectResponse struct {
+	Id                   []byte    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Vector               []float32 `protobuf:"fixed32,2,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	Meta                 []byte    `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	Error                string    `protobuf:"bytes,99,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-type nGTDStreamGetObjectServer struct {
-	grpc.ServerStream
+func (m *GetObjectResponse) Reset()         { *m = GetObjectResponse{} }
+func (m *GetObjectResponse) String() string { return proto.CompactTextString(m) }
+func (*GetObjectResponse) ProtoMessage()    {}
+func (*GetObjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{18}
+}
+func (m *GetObjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetObjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetObjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetObjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetObjectResponse.Merge(m, src)
+}
+func (m *GetObjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetObjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetObjectResponse.DiscardUnknown(m)
 }
 
-func (x *nGTDStreamGetObjectServer) Send(m *GetObjectResponse) error {
-	return x.ServerStream.SendMsg(m)
+var xxx_messageInfo_GetObjectResponse proto.InternalMessageInfo
+
+func (m *GetObjectResponse) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
 }
 
-func (x *nGTDStreamGetObjectServer) Recv() (*GetObjectRequest, error) {
-	m := new(GetObjectRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GetObjectResponse) GetVector() []float32 {
+	if m != nil {
+		return m.Vector
 	}
-	return m, nil
+	return nil
 }
 
-func _NGTD_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateIndexRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GetObjectResponse) GetMeta() []byte {
+	if m != nil {
+		return m.Meta
 	}
-	if interceptor == nil {
-		return srv.(NGTDServer).CreateIndex(ctx, in)
+	return nil
+}
+
+func (m *GetObjectResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ngtd.NGTD/CreateIndex",
+	return ""
+}
+
+type ListIDsRequest struct {
+	Cursor               []byte   `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Prefix               []byte   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Collection           string   `protobuf:"bytes,4,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListIDsRequest) Reset()         { *m = ListIDsRequest{} }
+func (m *ListIDsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListIDsRequest) ProtoMessage()    {}
+func (*ListIDsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{19}
+}
+func (m *ListIDsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListIDsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListIDsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NGTDServer).CreateIndex(ctx, req.(*CreateIndexRequest))
+}
+func (m *ListIDsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIDsRequest.Merge(m, src)
+}
+func (m *ListIDsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListIDsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIDsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListIDsRequest proto.InternalMessageInfo
+
+func (m *ListIDsRequest) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _NGTD_SaveIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ListIDsRequest) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
 	}
-	if interceptor == nil {
-		return srv.(NGTDServer).SaveIndex(ctx, in)
+	return nil
+}
+
+func (m *ListIDsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ngtd.NGTD/SaveIndex",
+	return 0
+}
+
+func (m *ListIDsRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NGTDServer).SaveIndex(ctx, req.(*Empty))
+	return ""
+}
+
+type ListIDsResponse struct {
+	Ids                  [][]byte `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Next                 []byte   `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListIDsResponse) Reset()         { *m = ListIDsResponse{} }
+func (m *ListIDsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListIDsResponse) ProtoMessage()    {}
+func (*ListIDsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{20}
+}
+func (m *ListIDsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListIDsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListIDsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ListIDsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListIDsResponse.Merge(m, src)
+}
+func (m *ListIDsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListIDsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListIDsResponse.DiscardUnknown(m)
 }
 
-func _NGTD_GetDimension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_ListIDsResponse proto.InternalMessageInfo
+
+func (m *ListIDsResponse) GetIds() [][]byte {
+	if m != nil {
+		return m.Ids
 	}
-	if interceptor == nil {
-		return srv.(NGTDServer).GetDimension(ctx, in)
+	return nil
+}
+
+func (m *ListIDsResponse) GetNext() []byte {
+	if m != nil {
+		return m.Next
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ngtd.NGTD/GetDimension",
+	return nil
+}
+
+type CountResponse struct {
+	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CountResponse) Reset()         { *m = CountResponse{} }
+func (m *CountResponse) String() string { return proto.CompactTextString(m) }
+func (*CountResponse) ProtoMessage()    {}
+func (*CountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{21}
+}
+func (m *CountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NGTDServer).GetDimension(ctx, req.(*Empty))
+}
+func (m *CountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountResponse.Merge(m, src)
+}
+func (m *CountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountResponse proto.InternalMessageInfo
+
+func (m *CountResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _NGTD_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ngtd.NGTD",
-	HandlerType: (*NGTDServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Search",
-			Handler:    _NGTD_Search_Handler,
-		},
-		{
-			MethodName: "SearchByID",
-			Handler:    _NGTD_SearchByID_Handler,
-		},
-		{
-			MethodName: "Insert",
-			Handler:    _NGTD_Insert_Handler,
-		},
-		{
-			MethodName: "Remove",
-			Handler:    _NGTD_Remove_Handler,
-		},
-		{
-			MethodName: "GetObject",
-			Handler:    _NGTD_GetObject_Handler,
-		},
-		{
-			MethodName: "CreateIndex",
-			Handler:    _NGTD_CreateIndex_Handler,
-		},
-		{
-			MethodName: "SaveIndex",
-			Handler:    _NGTD_SaveIndex_Handler,
-		},
-		{
-			MethodName: "GetDimension",
-			Handler:    _NGTD_GetDimension_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "StreamSearch",
-			Handler:       _NGTD_StreamSearch_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "StreamSearchByID",
-			Handler:       _NGTD_StreamSearchByID_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "StreamInsert",
-			Handler:       _NGTD_StreamInsert_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "StreamRemove",
-			Handler:       _NGTD_StreamRemove_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "StreamGetObject",
-			Handler:       _NGTD_StreamGetObject_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "proto/ngtd.proto",
+type StatsResponse struct {
+	Objects              int64            `protobuf:"varint,1,opt,name=objects,proto3" json:"objects,omitempty"`
+	NgtObjects           int64            `protobuf:"varint,2,opt,name=ngt_objects,json=ngtObjects,proto3" json:"ngt_objects,omitempty"`
+	IndexedObjects       int64            `protobuf:"varint,3,opt,name=indexed_objects,json=indexedObjects,proto3" json:"indexed_objects,omitempty"`
+	UnindexedObjects     int64            `protobuf:"varint,4,opt,name=unindexed_objects,json=unindexedObjects,proto3" json:"unindexed_objects,omitempty"`
+	LastCreateIndex      int64            `protobuf:"varint,5,opt,name=last_create_index,json=lastCreateIndex,proto3" json:"last_create_index,omitempty"`
+	CreateIndexSeconds   float64          `protobuf:"fixed64,6,opt,name=create_index_seconds,json=createIndexSeconds,proto3" json:"create_index_seconds,omitempty"`
+	LastSaveIndex        int64            `protobuf:"varint,7,opt,name=last_save_index,json=lastSaveIndex,proto3" json:"last_save_index,omitempty"`
+	SaveIndexSeconds     float64          `protobuf:"fixed64,8,opt,name=save_index_seconds,json=saveIndexSeconds,proto3" json:"save_index_seconds,omitempty"`
+	IndexSize            int64            `protobuf:"varint,9,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
+	KvsType              string           `protobuf:"bytes,10,opt,name=kvs_type,json=kvsType,proto3" json:"kvs_type,omitempty"`
+	UptimeSeconds        float64          `protobuf:"fixed64,11,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	AutoIndex            *AutoIndexStatus `protobuf:"bytes,12,opt,name=auto_index,json=autoIndex,proto3" json:"auto_index,omitempty"`
+	UnsavedObjects       int64            `protobuf:"varint,13,opt,name=unsaved_objects,json=unsavedObjects,proto3" json:"unsaved_objects,omitempty"`
+	AutoSave             *AutoSaveStatus  `protobuf:"bytes,14,opt,name=auto_save,json=autoSave,proto3" json:"auto_save,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *StatsResponse) Reset()         { *m = StatsResponse{} }
+func (m *StatsResponse) String() string { return proto.CompactTextString(m) }
+func (*StatsResponse) ProtoMessage()    {}
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{22}
+}
+func (m *StatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Empty) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *StatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsResponse.Merge(m, src)
+}
+func (m *StatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsResponse.DiscardUnknown(m)
 }
 
-func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_StatsResponse proto.InternalMessageInfo
+
+func (m *StatsResponse) GetObjects() int64 {
+	if m != nil {
+		return m.Objects
 	}
-	return i, nil
+	return 0
 }
 
-func (m *SearchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StatsResponse) GetNgtObjects() int64 {
+	if m != nil {
+		return m.NgtObjects
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SearchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vector) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Vector)*8))
-		for _, num := range m.Vector {
-			f1 := math.Float64bits(float64(num))
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f1))
-			i += 8
-		}
-	}
-	if len(m.Id) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if m.Size_ != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(m.Size_))
-	}
-	if m.Epsilon != 0 {
-		dAtA[i] = 0x5d
-		i++
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Epsilon))))
-		i += 4
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *StatsResponse) GetIndexedObjects() int64 {
+	if m != nil {
+		return m.IndexedObjects
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ObjectDistance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StatsResponse) GetUnindexedObjects() int64 {
+	if m != nil {
+		return m.UnindexedObjects
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ObjectDistance) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (m *StatsResponse) GetLastCreateIndex() int64 {
+	if m != nil {
+		return m.LastCreateIndex
 	}
-	if m.Distance != 0 {
-		dAtA[i] = 0x55
-		i++
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Distance))))
-		i += 4
+	return 0
+}
+
+func (m *StatsResponse) GetCreateIndexSeconds() float64 {
+	if m != nil {
+		return m.CreateIndexSeconds
 	}
-	if len(m.Error) > 0 {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Error)))
-		i += copy(dAtA[i:], m.Error)
+	return 0
+}
+
+func (m *StatsResponse) GetLastSaveIndex() int64 {
+	if m != nil {
+		return m.LastSaveIndex
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *StatsResponse) GetSaveIndexSeconds() float64 {
+	if m != nil {
+		return m.SaveIndexSeconds
 	}
-	return i, nil
+	return 0
 }
 
-func (m *SearchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StatsResponse) GetIndexSize() int64 {
+	if m != nil {
+		return m.IndexSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SearchResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Result) > 0 {
-		for _, msg := range m.Result {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintNgtd(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *StatsResponse) GetKvsType() string {
+	if m != nil {
+		return m.KvsType
 	}
-	if len(m.Error) > 0 {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Error)))
-		i += copy(dAtA[i:], m.Error)
+	return ""
+}
+
+func (m *StatsResponse) GetUptimeSeconds() float64 {
+	if m != nil {
+		return m.UptimeSeconds
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *StatsResponse) GetAutoIndex() *AutoIndexStatus {
+	if m != nil {
+		return m.AutoIndex
 	}
-	return i, nil
+	return nil
 }
 
-func (m *InsertRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StatsResponse) GetUnsavedObjects() int64 {
+	if m != nil {
+		return m.UnsavedObjects
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *InsertRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vector) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Vector)*8))
-		for _, num := range m.Vector {
-			f2 := math.Float64bits(float64(num))
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f2))
-			i += 8
-		}
+func (m *StatsResponse) GetAutoSave() *AutoSaveStatus {
+	if m != nil {
+		return m.AutoSave
 	}
-	if len(m.Id) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	return nil
+}
+
+type AutoIndexStatus struct {
+	Pending              int64    `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	IntervalSeconds      float64  `protobuf:"fixed64,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	PoolSize             int32    `protobuf:"varint,3,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	Runs                 int64    `protobuf:"varint,4,opt,name=runs,proto3" json:"runs,omitempty"`
+	LastRun              int64    `protobuf:"varint,5,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	LastRunSeconds       float64  `protobuf:"fixed64,6,opt,name=last_run_seconds,json=lastRunSeconds,proto3" json:"last_run_seconds,omitempty"`
+	LastError            string   `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AutoIndexStatus) Reset()         { *m = AutoIndexStatus{} }
+func (m *AutoIndexStatus) String() string { return proto.CompactTextString(m) }
+func (*AutoIndexStatus) ProtoMessage()    {}
+func (*AutoIndexStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{23}
+}
+func (m *AutoIndexStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoIndexStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoIndexStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (m *AutoIndexStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoIndexStatus.Merge(m, src)
+}
+func (m *AutoIndexStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoIndexStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoIndexStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoIndexStatus proto.InternalMessageInfo
+
+func (m *AutoIndexStatus) GetPending() int64 {
+	if m != nil {
+		return m.Pending
 	}
-	return i, nil
+	return 0
 }
 
-func (m *InsertResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *AutoIndexStatus) GetIntervalSeconds() float64 {
+	if m != nil {
+		return m.IntervalSeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *InsertResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Error)))
-		i += copy(dAtA[i:], m.Error)
+func (m *AutoIndexStatus) GetPoolSize() int32 {
+	if m != nil {
+		return m.PoolSize
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *AutoIndexStatus) GetRuns() int64 {
+	if m != nil {
+		return m.Runs
 	}
-	return i, nil
+	return 0
 }
 
-func (m *RemoveRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *AutoIndexStatus) GetLastRun() int64 {
+	if m != nil {
+		return m.LastRun
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RemoveRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (m *AutoIndexStatus) GetLastRunSeconds() float64 {
+	if m != nil {
+		return m.LastRunSeconds
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *AutoIndexStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
-	return i, nil
+	return ""
 }
 
-func (m *RemoveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+type AutoSaveStatus struct {
+	Unsaved              int64    `protobuf:"varint,1,opt,name=unsaved,proto3" json:"unsaved,omitempty"`
+	IntervalSeconds      float64  `protobuf:"fixed64,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	Runs                 int64    `protobuf:"varint,3,opt,name=runs,proto3" json:"runs,omitempty"`
+	LastRun              int64    `protobuf:"varint,4,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	LastRunSeconds       float64  `protobuf:"fixed64,5,opt,name=last_run_seconds,json=lastRunSeconds,proto3" json:"last_run_seconds,omitempty"`
+	LastError            string   `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AutoSaveStatus) Reset()         { *m = AutoSaveStatus{} }
+func (m *AutoSaveStatus) String() string { return proto.CompactTextString(m) }
+func (*AutoSaveStatus) ProtoMessage()    {}
+func (*AutoSaveStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{24}
+}
+func (m *AutoSaveStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoSaveStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoSaveStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *AutoSaveStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoSaveStatus.Merge(m, src)
+}
+func (m *AutoSaveStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoSaveStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoSaveStatus.DiscardUnknown(m)
 }
 
-func (m *RemoveResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Error)))
-		i += copy(dAtA[i:], m.Error)
+var xxx_messageInfo_AutoSaveStatus proto.InternalMessageInfo
+
+func (m *AutoSaveStatus) GetUnsaved() int64 {
+	if m != nil {
+		return m.Unsaved
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *AutoSaveStatus) GetIntervalSeconds() float64 {
+	if m != nil {
+		return m.IntervalSeconds
 	}
-	return i, nil
+	return 0
 }
 
-func (m *CreateIndexRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *AutoSaveStatus) GetRuns() int64 {
+	if m != nil {
+		return m.Runs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CreateIndexRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.PoolSize != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(m.PoolSize))
+func (m *AutoSaveStatus) GetLastRun() int64 {
+	if m != nil {
+		return m.LastRun
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *AutoSaveStatus) GetLastRunSeconds() float64 {
+	if m != nil {
+		return m.LastRunSeconds
 	}
-	return i, nil
+	return 0
 }
 
-func (m *GetDimensionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *AutoSaveStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetDimensionResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Dimension != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(m.Dimension))
+type HealthResponse struct {
+	Healthy              bool            `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Errors               []string        `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	LastSaveIndex        int64           `protobuf:"varint,3,opt,name=last_save_index,json=lastSaveIndex,proto3" json:"last_save_index,omitempty"`
+	UnsavedObjects       int64           `protobuf:"varint,4,opt,name=unsaved_objects,json=unsavedObjects,proto3" json:"unsaved_objects,omitempty"`
+	AutoSave             *AutoSaveStatus `protobuf:"bytes,5,opt,name=auto_save,json=autoSave,proto3" json:"auto_save,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *HealthResponse) Reset()         { *m = HealthResponse{} }
+func (m *HealthResponse) String() string { return proto.CompactTextString(m) }
+func (*HealthResponse) ProtoMessage()    {}
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{25}
+}
+func (m *HealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (m *HealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthResponse.Merge(m, src)
+}
+func (m *HealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthResponse proto.InternalMessageInfo
+
+func (m *HealthResponse) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
 	}
-	return i, nil
+	return false
 }
 
-func (m *GetObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *HealthResponse) GetErrors() []string {
+	if m != nil {
+		return m.Errors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (m *HealthResponse) GetLastSaveIndex() int64 {
+	if m != nil {
+		return m.LastSaveIndex
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return 0
+}
+
+func (m *HealthResponse) GetUnsavedObjects() int64 {
+	if m != nil {
+		return m.UnsavedObjects
 	}
-	return i, nil
+	return 0
 }
 
-func (m *GetObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *HealthResponse) GetAutoSave() *AutoSaveStatus {
+	if m != nil {
+		return m.AutoSave
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Vector) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Vector)*4))
-		for _, num := range m.Vector {
-			f3 := math.Float32bits(float32(num))
-			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f3))
-			i += 4
-		}
-	}
-	if len(m.Error) > 0 {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Error)))
-		i += copy(dAtA[i:], m.Error)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type SnapshotResponse struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func encodeVarintNgtd(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+func (m *SnapshotResponse) Reset()         { *m = SnapshotResponse{} }
+func (m *SnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*SnapshotResponse) ProtoMessage()    {}
+func (*SnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{26}
 }
-func (m *Empty) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *SnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotResponse.Merge(m, src)
+}
+func (m *SnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotResponse.DiscardUnknown(m)
 }
 
-func (m *SearchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Vector) > 0 {
-		n += 1 + sovNgtd(uint64(len(m.Vector)*8)) + len(m.Vector)*8
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovNgtd(uint64(l))
-	}
-	if m.Size_ != 0 {
-		n += 1 + sovNgtd(uint64(m.Size_))
-	}
-	if m.Epsilon != 0 {
-		n += 5
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_SnapshotResponse proto.InternalMessageInfo
+
+func (m *SnapshotResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return n
+	return nil
 }
 
-func (m *ObjectDistance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovNgtd(uint64(l))
-	}
-	if m.Distance != 0 {
-		n += 5
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 2 + l + sovNgtd(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type ReloadRequest struct {
+	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	DatabasePath         string   `protobuf:"bytes,2,opt,name=database_path,json=databasePath,proto3" json:"database_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SearchResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Result) > 0 {
-		for _, e := range m.Result {
-			l = e.Size()
-			n += 1 + l + sovNgtd(uint64(l))
+func (m *ReloadRequest) Reset()         { *m = ReloadRequest{} }
+func (m *ReloadRequest) String() string { return proto.CompactTextString(m) }
+func (*ReloadRequest) ProtoMessage()    {}
+func (*ReloadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{27}
+}
+func (m *ReloadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReloadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 2 + l + sovNgtd(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *ReloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadRequest.Merge(m, src)
+}
+func (m *ReloadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadRequest.DiscardUnknown(m)
 }
 
-func (m *InsertRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Vector) > 0 {
-		n += 1 + sovNgtd(uint64(len(m.Vector)*8)) + len(m.Vector)*8
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovNgtd(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_ReloadRequest proto.InternalMessageInfo
+
+func (m *ReloadRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return n
+	return ""
 }
 
-func (m *InsertResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 2 + l + sovNgtd(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ReloadRequest) GetDatabasePath() string {
+	if m != nil {
+		return m.DatabasePath
 	}
-	return n
+	return ""
 }
 
-func (m *RemoveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovNgtd(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type DuplicatesRequest struct {
+	Threshold            float32  `protobuf:"fixed32,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Epsilon              float32  `protobuf:"fixed32,2,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
+	Collection           string   `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 2 + l + sovNgtd(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DuplicatesRequest) Reset()         { *m = DuplicatesRequest{} }
+func (m *DuplicatesRequest) String() string { return proto.CompactTextString(m) }
+func (*DuplicatesRequest) ProtoMessage()    {}
+func (*DuplicatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{28}
+}
+func (m *DuplicatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DuplicatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DuplicatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *DuplicatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicatesRequest.Merge(m, src)
+}
+func (m *DuplicatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DuplicatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicatesRequest.DiscardUnknown(m)
 }
 
-func (m *CreateIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolSize != 0 {
-		n += 1 + sovNgtd(uint64(m.PoolSize))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_DuplicatesRequest proto.InternalMessageInfo
+
+func (m *DuplicatesRequest) GetThreshold() float32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return n
+	return 0
 }
 
-func (m *GetDimensionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Dimension != 0 {
-		n += 1 + sovNgtd(uint64(m.Dimension))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DuplicatesRequest) GetEpsilon() float32 {
+	if m != nil {
+		return m.Epsilon
 	}
-	return n
+	return 0
 }
 
-func (m *GetObjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DuplicatesRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
+	return ""
+}
+
+type DuplicatePair struct {
+	Id1                  []byte   `protobuf:"bytes,1,opt,name=id1,proto3" json:"id1,omitempty"`
+	Id2                  []byte   `protobuf:"bytes,2,opt,name=id2,proto3" json:"id2,omitempty"`
+	Distance             float32  `protobuf:"fixed32,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DuplicatePair) Reset()         { *m = DuplicatePair{} }
+func (m *DuplicatePair) String() string { return proto.CompactTextString(m) }
+func (*DuplicatePair) ProtoMessage()    {}
+func (*DuplicatePair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{29}
+}
+func (m *DuplicatePair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DuplicatePair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DuplicatePair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DuplicatePair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicatePair.Merge(m, src)
+}
+func (m *DuplicatePair) XXX_Size() int {
+	return m.Size()
+}
+func (m *DuplicatePair) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicatePair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicatePair proto.InternalMessageInfo
+
+func (m *DuplicatePair) GetId1() []byte {
+	if m != nil {
+		return m.Id1
+	}
+	return nil
+}
+
+func (m *DuplicatePair) GetId2() []byte {
+	if m != nil {
+		return m.Id2
+	}
+	return nil
+}
+
+func (m *DuplicatePair) GetDistance() float32 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+type DuplicatesResponse struct {
+	Pairs                []*DuplicatePair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	Done                 int32            `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	Total                int32            `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Error                string           `protobuf:"bytes,99,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DuplicatesResponse) Reset()         { *m = DuplicatesResponse{} }
+func (m *DuplicatesResponse) String() string { return proto.CompactTextString(m) }
+func (*DuplicatesResponse) ProtoMessage()    {}
+func (*DuplicatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{30}
+}
+func (m *DuplicatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DuplicatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DuplicatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DuplicatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicatesResponse.Merge(m, src)
+}
+func (m *DuplicatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DuplicatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicatesResponse proto.InternalMessageInfo
+
+func (m *DuplicatesResponse) GetPairs() []*DuplicatePair {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+func (m *DuplicatesResponse) GetDone() int32 {
+	if m != nil {
+		return m.Done
+	}
+	return 0
+}
+
+func (m *DuplicatesResponse) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *DuplicatesResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type Collection struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Dimension            int32    `protobuf:"varint,2,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	DistanceType         string   `protobuf:"bytes,3,opt,name=distance_type,json=distanceType,proto3" json:"distance_type,omitempty"`
+	ObjectType           string   `protobuf:"bytes,4,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	Normalize            bool     `protobuf:"varint,5,opt,name=normalize,proto3" json:"normalize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Collection) Reset()         { *m = Collection{} }
+func (m *Collection) String() string { return proto.CompactTextString(m) }
+func (*Collection) ProtoMessage()    {}
+func (*Collection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{31}
+}
+func (m *Collection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Collection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Collection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Collection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Collection.Merge(m, src)
+}
+func (m *Collection) XXX_Size() int {
+	return m.Size()
+}
+func (m *Collection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Collection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Collection proto.InternalMessageInfo
+
+func (m *Collection) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Collection) GetDimension() int32 {
+	if m != nil {
+		return m.Dimension
+	}
+	return 0
+}
+
+func (m *Collection) GetDistanceType() string {
+	if m != nil {
+		return m.DistanceType
+	}
+	return ""
+}
+
+func (m *Collection) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *Collection) GetNormalize() bool {
+	if m != nil {
+		return m.Normalize
+	}
+	return false
+}
+
+type ListCollectionsResponse struct {
+	Collections          []*Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListCollectionsResponse) Reset()         { *m = ListCollectionsResponse{} }
+func (m *ListCollectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCollectionsResponse) ProtoMessage()    {}
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af2a3ceaadf6e6af, []int{32}
+}
+func (m *ListCollectionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCollectionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListCollectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCollectionsResponse.Merge(m, src)
+}
+func (m *ListCollectionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCollectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCollectionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCollectionsResponse proto.InternalMessageInfo
+
+func (m *ListCollectionsResponse) GetCollections() []*Collection {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Empty)(nil), "ngtd.Empty")
+	proto.RegisterType((*SearchRequest)(nil), "ngtd.SearchRequest")
+	proto.RegisterType((*Term)(nil), "ngtd.Term")
+	proto.RegisterType((*Example)(nil), "ngtd.Example")
+	proto.RegisterType((*SearchStats)(nil), "ngtd.SearchStats")
+	proto.RegisterType((*ObjectDistance)(nil), "ngtd.ObjectDistance")
+	proto.RegisterType((*SearchResponse)(nil), "ngtd.SearchResponse")
+	proto.RegisterType((*MultiSearchRequest)(nil), "ngtd.MultiSearchRequest")
+	proto.RegisterType((*MultiSearchResponse)(nil), "ngtd.MultiSearchResponse")
+	proto.RegisterType((*InsertRequest)(nil), "ngtd.InsertRequest")
+	proto.RegisterType((*InsertResponse)(nil), "ngtd.InsertResponse")
+	proto.RegisterType((*UpsertResponse)(nil), "ngtd.UpsertResponse")
+	proto.RegisterType((*RemoveRequest)(nil), "ngtd.RemoveRequest")
+	proto.RegisterType((*RemoveResponse)(nil), "ngtd.RemoveResponse")
+	proto.RegisterType((*CreateIndexRequest)(nil), "ngtd.CreateIndexRequest")
+	proto.RegisterType((*CollectionRequest)(nil), "ngtd.CollectionRequest")
+	proto.RegisterType((*GetDimensionResponse)(nil), "ngtd.GetDimensionResponse")
+	proto.RegisterType((*GetObjectRequest)(nil), "ngtd.GetObjectRequest")
+	proto.RegisterType((*GetObjectResponse)(nil), "ngtd.GetObjectResponse")
+	proto.RegisterType((*ListIDsRequest)(nil), "ngtd.ListIDsRequest")
+	proto.RegisterType((*ListIDsResponse)(nil), "ngtd.ListIDsResponse")
+	proto.RegisterType((*CountResponse)(nil), "ngtd.CountResponse")
+	proto.RegisterType((*StatsResponse)(nil), "ngtd.StatsResponse")
+	proto.RegisterType((*AutoIndexStatus)(nil), "ngtd.AutoIndexStatus")
+	proto.RegisterType((*AutoSaveStatus)(nil), "ngtd.AutoSaveStatus")
+	proto.RegisterType((*HealthResponse)(nil), "ngtd.HealthResponse")
+	proto.RegisterType((*SnapshotResponse)(nil), "ngtd.SnapshotResponse")
+	proto.RegisterType((*ReloadRequest)(nil), "ngtd.ReloadRequest")
+	proto.RegisterType((*DuplicatesRequest)(nil), "ngtd.DuplicatesRequest")
+	proto.RegisterType((*DuplicatePair)(nil), "ngtd.DuplicatePair")
+	proto.RegisterType((*DuplicatesResponse)(nil), "ngtd.DuplicatesResponse")
+	proto.RegisterType((*Collection)(nil), "ngtd.Collection")
+	proto.RegisterType((*ListCollectionsResponse)(nil), "ngtd.ListCollectionsResponse")
+}
+
+func init() { proto.RegisterFile("proto/ngtd.proto", fileDescriptor_af2a3ceaadf6e6af) }
+
+var fileDescriptor_af2a3ceaadf6e6af = []byte{
+	// 2022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5f, 0x73, 0x1c, 0x47,
+	0x11, 0xd7, 0xde, 0xff, 0xeb, 0xd3, 0x9d, 0x4e, 0x63, 0xc5, 0x5e, 0x9f, 0x6d, 0x45, 0x2c, 0x15,
+	0xe7, 0x4c, 0x5c, 0x89, 0x2d, 0xc7, 0x24, 0x50, 0x14, 0x26, 0x96, 0x1c, 0x45, 0x14, 0x22, 0x61,
+	0x65, 0x3f, 0x5f, 0x8d, 0x77, 0xc7, 0xba, 0x45, 0x7b, 0xbb, 0xcb, 0xce, 0xec, 0x45, 0x4a, 0x51,
+	0x45, 0x15, 0x9f, 0x82, 0x67, 0x3e, 0x04, 0x0f, 0x7c, 0x00, 0xe0, 0x85, 0x2a, 0x3e, 0x02, 0x65,
+	0x78, 0xe3, 0x4b, 0x50, 0xd3, 0x33, 0xb3, 0x7f, 0xee, 0x4e, 0x96, 0x65, 0x78, 0x9b, 0xee, 0xe9,
+	0xee, 0xe9, 0xee, 0xf9, 0x6d, 0x4f, 0xf7, 0xc2, 0x30, 0x49, 0x63, 0x11, 0x7f, 0x12, 0x9d, 0x08,
+	0xff, 0x63, 0x5c, 0x92, 0x86, 0x5c, 0x3b, 0x6d, 0x68, 0x3e, 0x9b, 0x25, 0xe2, 0xdc, 0xf9, 0x77,
+	0x03, 0xfa, 0xc7, 0x8c, 0xa6, 0xde, 0xd4, 0x65, 0xbf, 0xc9, 0x18, 0x17, 0xe4, 0x3a, 0xb4, 0xe6,
+	0xcc, 0x13, 0x71, 0x6a, 0x5b, 0x3b, 0xf5, 0xb1, 0xe5, 0x6a, 0x8a, 0x0c, 0xa0, 0x16, 0xf8, 0x76,
+	0x6d, 0xc7, 0x1a, 0xaf, 0xbb, 0xb5, 0xc0, 0x27, 0xdb, 0x00, 0x5e, 0x1c, 0x86, 0xcc, 0x13, 0x41,
+	0x1c, 0xd9, 0xf5, 0x1d, 0x6b, 0xdc, 0x75, 0x4b, 0x1c, 0x72, 0x0b, 0xba, 0xdf, 0x06, 0x62, 0x3a,
+	0x99, 0x31, 0x41, 0xed, 0xc6, 0x8e, 0x35, 0xee, 0xb8, 0x1d, 0xc9, 0x38, 0x62, 0x82, 0xca, 0x43,
+	0x5e, 0x05, 0xa1, 0x60, 0xa9, 0xdd, 0x44, 0x45, 0x4d, 0x91, 0x0f, 0x60, 0x30, 0xa3, 0x67, 0x13,
+	0x8f, 0x46, 0x7e, 0xe0, 0x53, 0xc1, 0xb8, 0xdd, 0xda, 0xb1, 0xc6, 0x4d, 0xb7, 0x3f, 0xa3, 0x67,
+	0x7b, 0x39, 0x53, 0xaa, 0xa7, 0xd4, 0x0f, 0x32, 0x6e, 0xb7, 0x77, 0xac, 0x71, 0xcd, 0xd5, 0x14,
+	0xf9, 0x1e, 0xac, 0xb3, 0x33, 0x2f, 0xcc, 0x7c, 0x36, 0xe1, 0x2c, 0x7c, 0x65, 0x77, 0xf0, 0xd8,
+	0x9e, 0xe6, 0x1d, 0xb3, 0xf0, 0x15, 0x79, 0x1f, 0x0c, 0x39, 0x09, 0x7c, 0x6e, 0x77, 0x77, 0xea,
+	0xe3, 0x75, 0x17, 0x34, 0xeb, 0xd0, 0xe7, 0x84, 0x40, 0x83, 0x07, 0xdf, 0x31, 0x1b, 0xf0, 0x60,
+	0x5c, 0x13, 0x1b, 0xda, 0x2c, 0xe1, 0x41, 0x18, 0x47, 0x76, 0x0f, 0x0f, 0x34, 0x24, 0xf9, 0x08,
+	0x36, 0x53, 0x96, 0xd2, 0xe8, 0xb4, 0xec, 0xf3, 0x3a, 0xaa, 0x0e, 0xd5, 0x46, 0xc9, 0xed, 0xef,
+	0x43, 0x5f, 0x0b, 0xcf, 0x98, 0x48, 0x03, 0xcf, 0xee, 0x63, 0xf0, 0xeb, 0x8a, 0x79, 0x84, 0x3c,
+	0x72, 0x07, 0x00, 0xf3, 0xc6, 0x05, 0x15, 0xdc, 0x1e, 0x60, 0x04, 0x98, 0xc9, 0x63, 0xc9, 0x90,
+	0xdb, 0xdc, 0x8b, 0x53, 0x36, 0x99, 0xc5, 0x3e, 0xb3, 0x37, 0xd0, 0x40, 0x17, 0x39, 0x47, 0xb1,
+	0xcf, 0xc8, 0x3d, 0xe8, 0x24, 0x31, 0x0f, 0x44, 0x30, 0x67, 0xf6, 0x70, 0xa7, 0x3e, 0xee, 0xed,
+	0xf6, 0x3f, 0xc6, 0xdb, 0x7f, 0x76, 0x46, 0x67, 0x49, 0xc8, 0xdc, 0x7c, 0x5b, 0x8a, 0x46, 0xec,
+	0x84, 0xa2, 0xe8, 0xe6, 0x4a, 0x51, 0xb3, 0x4d, 0x76, 0xa0, 0x29, 0x58, 0x3a, 0xe3, 0x36, 0x41,
+	0x39, 0x50, 0x72, 0xcf, 0x59, 0x3a, 0x73, 0xd5, 0x86, 0x74, 0x6b, 0x4a, 0xf9, 0x44, 0xdf, 0xca,
+	0x35, 0xe5, 0xf5, 0x94, 0x72, 0x17, 0x19, 0xce, 0x97, 0xd0, 0x90, 0xd2, 0x1a, 0x44, 0x56, 0x0e,
+	0xa2, 0x02, 0x6c, 0xb5, 0x0a, 0xd8, 0xae, 0x43, 0xeb, 0x5b, 0x16, 0x9c, 0x4c, 0x05, 0x02, 0xab,
+	0xe6, 0x6a, 0xca, 0x79, 0x08, 0x6d, 0xed, 0xdd, 0x2a, 0x53, 0x5a, 0xa5, 0x56, 0x51, 0xf9, 0xbd,
+	0x05, 0x3d, 0x85, 0x70, 0x95, 0xc0, 0x5b, 0xd0, 0xe5, 0x48, 0x4e, 0x66, 0x1c, 0xd5, 0x2d, 0xb7,
+	0xa3, 0x18, 0x47, 0xb8, 0x69, 0x6e, 0x88, 0xa3, 0x1d, 0xcb, 0xed, 0xe8, 0xdb, 0xe1, 0xe4, 0x26,
+	0x74, 0x44, 0x2c, 0x68, 0x28, 0xf7, 0xea, 0xb8, 0xd7, 0x46, 0xfa, 0x88, 0xe3, 0xc7, 0x50, 0xdc,
+	0x7f, 0x03, 0xef, 0xbf, 0xc4, 0x71, 0x7e, 0x0b, 0x83, 0xaf, 0x5f, 0xfe, 0x9a, 0x79, 0x62, 0x3f,
+	0xe0, 0x82, 0x46, 0xde, 0xb2, 0xfb, 0x04, 0x1a, 0xf8, 0xa5, 0xa8, 0x0f, 0x0c, 0xd7, 0x64, 0x04,
+	0x1d, 0x5f, 0xcb, 0x23, 0x1c, 0x6b, 0x6e, 0x4e, 0x93, 0x2d, 0x68, 0xe2, 0xad, 0x6b, 0x40, 0x2a,
+	0x42, 0x72, 0x59, 0x9a, 0xc6, 0xa9, 0xed, 0x21, 0x30, 0x14, 0xe1, 0xfc, 0xd9, 0x82, 0x81, 0xf9,
+	0xc8, 0x79, 0x12, 0x47, 0x9c, 0x91, 0xfb, 0xd0, 0x4a, 0x19, 0xcf, 0x42, 0x81, 0x5f, 0x79, 0x6f,
+	0x77, 0x4b, 0x5d, 0x69, 0xd5, 0x49, 0x57, 0xcb, 0x90, 0x0f, 0xa1, 0xa9, 0xe0, 0x28, 0xbd, 0xeb,
+	0xed, 0x6e, 0x2a, 0xe1, 0x52, 0x56, 0x5d, 0xb5, 0xbf, 0x80, 0xce, 0xfa, 0x22, 0x3a, 0x6f, 0x43,
+	0x57, 0xa4, 0x59, 0xe4, 0x51, 0xc1, 0x7c, 0x5d, 0x13, 0x0a, 0xc6, 0x05, 0xce, 0x33, 0x20, 0x47,
+	0x59, 0x28, 0x82, 0x6a, 0x95, 0xfa, 0x04, 0x3a, 0xa9, 0x5a, 0x72, 0x1d, 0xc1, 0xb5, 0xb2, 0x53,
+	0x5a, 0xcc, 0xcd, 0x85, 0x16, 0xca, 0x55, 0x6d, 0xb1, 0x5c, 0x39, 0x87, 0x70, 0xad, 0x72, 0x8c,
+	0xce, 0xd3, 0xae, 0x04, 0x84, 0x5a, 0xf3, 0x6a, 0xaa, 0xaa, 0x82, 0x6e, 0x21, 0xe6, 0x9c, 0x42,
+	0xff, 0x30, 0xe2, 0x2c, 0x15, 0xff, 0xef, 0x92, 0x6a, 0x30, 0xd2, 0x28, 0x30, 0xe2, 0xdc, 0x85,
+	0x81, 0x39, 0x4c, 0xbb, 0xbc, 0x3a, 0x8d, 0x4f, 0x61, 0xf0, 0x22, 0xa9, 0xc8, 0x8d, 0x64, 0x0a,
+	0x93, 0x90, 0x7a, 0x4c, 0xe1, 0xb0, 0xe3, 0xe6, 0xf4, 0x05, 0x36, 0x9e, 0x40, 0xdf, 0x65, 0xb3,
+	0x78, 0xce, 0x4c, 0x60, 0x8b, 0x20, 0xbe, 0x2c, 0xc9, 0x77, 0x61, 0x60, 0x0c, 0xbc, 0xd1, 0xd9,
+	0x5f, 0x01, 0xd9, 0x4b, 0x19, 0x15, 0xec, 0x30, 0xf2, 0xd9, 0x99, 0x39, 0xed, 0x16, 0x74, 0x93,
+	0x38, 0x0e, 0x27, 0x58, 0x9e, 0xe5, 0xa1, 0x7d, 0x59, 0xcd, 0xe2, 0xf0, 0x58, 0x96, 0xe8, 0xcb,
+	0x8e, 0x7e, 0x04, 0x9b, 0x7b, 0x39, 0x65, 0x2c, 0x56, 0x95, 0xac, 0x25, 0xa5, 0x4f, 0x61, 0xeb,
+	0x80, 0x89, 0xfd, 0x60, 0xc6, 0x22, 0x8e, 0x6a, 0xda, 0xeb, 0xdb, 0xd0, 0xf5, 0x0d, 0x13, 0xd5,
+	0x9a, 0x6e, 0xc1, 0x70, 0x9e, 0xc2, 0xf0, 0x80, 0x09, 0xf5, 0x29, 0xbd, 0x6b, 0xa6, 0x18, 0x6c,
+	0x96, 0x6c, 0xe8, 0x63, 0xdf, 0x5c, 0x3d, 0x6b, 0x39, 0xae, 0x0c, 0x4e, 0xea, 0xa5, 0x5a, 0xb2,
+	0x3a, 0xd1, 0x73, 0x18, 0xfc, 0x22, 0xe0, 0xe2, 0x70, 0x9f, 0x97, 0xb0, 0xea, 0x65, 0x29, 0x47,
+	0xac, 0x4a, 0x6d, 0x4d, 0x49, 0x7e, 0x92, 0xb2, 0x57, 0xc1, 0x99, 0xc6, 0xab, 0xa6, 0xa4, 0xdd,
+	0x30, 0x98, 0x05, 0xaa, 0x50, 0x37, 0x5d, 0x45, 0x2c, 0x84, 0xd7, 0x58, 0x0a, 0xef, 0x33, 0xd8,
+	0xc8, 0xcf, 0xd5, 0xc1, 0x0d, 0xa1, 0x2e, 0x1f, 0x64, 0x0b, 0x1f, 0x64, 0xb9, 0x94, 0x61, 0x44,
+	0xec, 0x4c, 0x98, 0x92, 0x28, 0xd7, 0xce, 0x07, 0xd0, 0xdf, 0x8b, 0xb3, 0xa8, 0x82, 0x76, 0x4f,
+	0x32, 0xd0, 0xdd, 0xba, 0xab, 0x08, 0xe7, 0xaf, 0xb2, 0xad, 0xc1, 0xc2, 0x64, 0xe4, 0x6c, 0x68,
+	0xc7, 0x98, 0x4d, 0xae, 0x25, 0x0d, 0x29, 0x3b, 0x82, 0xe8, 0x44, 0x4c, 0xcc, 0x6e, 0x0d, 0x77,
+	0x21, 0x3a, 0xd1, 0xd9, 0xe7, 0xe4, 0x43, 0xd8, 0x08, 0x24, 0x0e, 0x99, 0x9f, 0x0b, 0xd5, 0x51,
+	0x68, 0xa0, 0xd9, 0x46, 0xf0, 0x23, 0xd8, 0xcc, 0xa2, 0x45, 0xd1, 0x06, 0x8a, 0x0e, 0xb3, 0x68,
+	0x41, 0xf8, 0x07, 0xb0, 0x19, 0x52, 0x2e, 0x26, 0x1e, 0x02, 0x7d, 0x82, 0xbb, 0xd8, 0x0d, 0xd5,
+	0xdd, 0x0d, 0xb9, 0x51, 0xfa, 0x00, 0xc8, 0x03, 0xd8, 0x2a, 0x8b, 0x4d, 0x38, 0xf3, 0xe2, 0xc8,
+	0x57, 0xcd, 0x91, 0xe5, 0x12, 0xaf, 0x10, 0x3d, 0x56, 0x3b, 0xe4, 0x2e, 0xa0, 0x91, 0x09, 0xa7,
+	0x73, 0x63, 0xbb, 0x8d, 0xb6, 0xfb, 0x92, 0x7d, 0x4c, 0xe7, 0xda, 0xf2, 0x7d, 0x20, 0x85, 0x48,
+	0x6e, 0xb7, 0x83, 0x76, 0x87, 0x9c, 0xce, 0xab, 0x56, 0xef, 0x00, 0x68, 0x41, 0xf9, 0x09, 0x76,
+	0xd1, 0x60, 0x17, 0x39, 0xf8, 0x0d, 0xde, 0x84, 0xce, 0xe9, 0x9c, 0x4f, 0xc4, 0x79, 0xa2, 0xde,
+	0xab, 0xae, 0xdb, 0x3e, 0x9d, 0xf3, 0xe7, 0xe7, 0x09, 0x93, 0x8d, 0x5d, 0x96, 0x88, 0x60, 0xc6,
+	0xf2, 0x33, 0x7a, 0x78, 0x46, 0x5f, 0x71, 0xcd, 0x01, 0x9f, 0x02, 0xd0, 0x4c, 0xc4, 0xda, 0xe3,
+	0x75, 0x7c, 0x6d, 0xde, 0x53, 0xf5, 0xf6, 0x8b, 0x4c, 0xc4, 0xca, 0x19, 0x41, 0x45, 0xc6, 0xdd,
+	0x2e, 0x35, 0x0c, 0x79, 0x41, 0x59, 0x24, 0x9d, 0x2d, 0xb2, 0xde, 0x57, 0x17, 0xa4, 0xd9, 0x26,
+	0xe7, 0x0f, 0x01, 0xb5, 0x30, 0x2b, 0xd8, 0x5a, 0xe5, 0xd5, 0x5c, 0x5a, 0x97, 0x59, 0xd1, 0xc6,
+	0x3b, 0x54, 0xd3, 0xce, 0x7f, 0x2c, 0xd8, 0x58, 0x38, 0x5a, 0x62, 0x29, 0x61, 0x91, 0x1f, 0x44,
+	0x27, 0x06, 0x4b, 0x9a, 0x24, 0xf7, 0x60, 0x18, 0x44, 0x82, 0xa5, 0x73, 0x1a, 0xe6, 0x81, 0xaa,
+	0x36, 0x62, 0xc3, 0xf0, 0x4d, 0xa8, 0x95, 0x6a, 0xa6, 0x3e, 0x9e, 0xa2, 0x9a, 0x11, 0x68, 0xa4,
+	0x59, 0x64, 0xc0, 0x83, 0x6b, 0x99, 0x5d, 0xbc, 0xd2, 0x34, 0x8b, 0x34, 0x4e, 0xda, 0x92, 0x76,
+	0xb3, 0x88, 0x8c, 0x61, 0x68, 0xb6, 0x16, 0xb0, 0x31, 0xd0, 0x22, 0xa5, 0x1b, 0x44, 0x49, 0x55,
+	0x0b, 0xda, 0xea, 0x81, 0x96, 0x9c, 0x67, 0x58, 0x0f, 0xfe, 0x6e, 0xc1, 0xa0, 0x9a, 0x0a, 0x19,
+	0xac, 0xce, 0xa2, 0x09, 0x56, 0x93, 0x57, 0x09, 0xd6, 0xc4, 0x53, 0xbf, 0x20, 0x9e, 0xc6, 0xe5,
+	0xf1, 0x34, 0xdf, 0x22, 0x9e, 0xd6, 0x62, 0x3c, 0x7f, 0xb1, 0x60, 0xf0, 0x15, 0xa3, 0xa1, 0x98,
+	0x96, 0x0b, 0xc1, 0x14, 0x39, 0xe7, 0xfa, 0xd5, 0x33, 0xa4, 0x2c, 0x71, 0x68, 0x86, 0x63, 0x39,
+	0xed, 0xba, 0x9a, 0x5a, 0xf5, 0x2d, 0xd5, 0x57, 0x7d, 0x4b, 0x2b, 0x60, 0xd8, 0xb8, 0x1c, 0x86,
+	0xcd, 0xb7, 0x82, 0xe1, 0x5d, 0x18, 0x1e, 0x47, 0x34, 0xe1, 0xd3, 0xb8, 0x28, 0x7d, 0x04, 0x1a,
+	0x3e, 0x15, 0x54, 0x17, 0x6a, 0x5c, 0x3b, 0x3f, 0x97, 0x4f, 0x74, 0x18, 0x53, 0xdf, 0xd4, 0xf3,
+	0x2d, 0x68, 0x2a, 0x97, 0xd5, 0xeb, 0xa6, 0x08, 0x39, 0x89, 0x48, 0xf1, 0x97, 0x94, 0xb3, 0x49,
+	0x42, 0xc5, 0x54, 0xbf, 0x40, 0xeb, 0x86, 0xf9, 0x0d, 0x15, 0x53, 0xe7, 0x14, 0x36, 0xf7, 0xb3,
+	0x24, 0x0c, 0x3c, 0x2a, 0x58, 0xfe, 0x3e, 0xc8, 0x16, 0x6e, 0x9a, 0x32, 0x3e, 0x8d, 0x43, 0x05,
+	0x88, 0x9a, 0x5b, 0x30, 0xca, 0x83, 0x52, 0xad, 0x3a, 0x28, 0x5d, 0xd2, 0xdb, 0x38, 0x5f, 0x43,
+	0x3f, 0x3f, 0xec, 0x1b, 0x1a, 0xa4, 0xea, 0x3d, 0x78, 0xa8, 0x83, 0x93, 0x4b, 0xc5, 0xd9, 0xd5,
+	0xcf, 0x81, 0x5c, 0x56, 0x1a, 0xe4, 0x7a, 0xb5, 0x41, 0x76, 0x7e, 0x07, 0xa4, 0xec, 0xbd, 0xce,
+	0xd9, 0x3d, 0x68, 0x26, 0x34, 0x48, 0x17, 0x9a, 0xc6, 0xca, 0xc9, 0xae, 0x92, 0xc0, 0xf4, 0xc6,
+	0x11, 0xc3, 0xf3, 0x9a, 0x2e, 0xae, 0x65, 0x36, 0xb1, 0xe5, 0x37, 0xaf, 0x1d, 0x12, 0x17, 0xbc,
+	0xad, 0x7f, 0xb4, 0x00, 0xf6, 0x2a, 0xcd, 0x5b, 0x44, 0x67, 0x4c, 0xdf, 0x03, 0xae, 0xab, 0x7d,
+	0x44, 0x6d, 0xa1, 0x8f, 0xc0, 0x4b, 0xd2, 0xd1, 0xa8, 0x9a, 0x5a, 0xd7, 0x97, 0xa4, 0x99, 0x58,
+	0x58, 0xdf, 0x87, 0x9e, 0x02, 0x9b, 0x12, 0xd1, 0x4f, 0xad, 0x62, 0xa1, 0xc0, 0x6d, 0xe8, 0x46,
+	0x71, 0x3a, 0xa3, 0x61, 0xf0, 0x9d, 0x02, 0x5b, 0xc7, 0x2d, 0x18, 0xce, 0x11, 0xdc, 0x90, 0x0f,
+	0x71, 0xe1, 0x27, 0x2f, 0xb5, 0xbe, 0xbd, 0xe2, 0x7e, 0x4c, 0xc2, 0x86, 0x2a, 0x61, 0x85, 0xbc,
+	0x5b, 0x16, 0xda, 0xfd, 0xd3, 0x00, 0x1a, 0xbf, 0x3c, 0x78, 0xbe, 0x4f, 0x1e, 0x43, 0x4b, 0x35,
+	0xc8, 0x64, 0x55, 0x5f, 0x3e, 0x5a, 0xd9, 0x43, 0x3b, 0x6b, 0xe4, 0x47, 0x00, 0x8a, 0xf7, 0xf4,
+	0xfc, 0x70, 0xff, 0x6a, 0xaa, 0x4f, 0x60, 0xfd, 0x58, 0xa4, 0x8c, 0xce, 0xde, 0xe1, 0xdc, 0xb1,
+	0xf5, 0xc0, 0x22, 0x7b, 0x30, 0x2c, 0x1b, 0xb8, 0xb2, 0x07, 0x68, 0x64, 0x1f, 0x7a, 0xa5, 0x31,
+	0x82, 0xd8, 0x4a, 0x74, 0x79, 0x80, 0x19, 0xdd, 0x5c, 0xb1, 0x93, 0xc7, 0xf2, 0x13, 0x33, 0xb2,
+	0xba, 0x34, 0x3a, 0x61, 0x57, 0xf2, 0xe2, 0x81, 0x45, 0x7e, 0x06, 0x1b, 0x25, 0xed, 0x2b, 0xc7,
+	0xf1, 0xc0, 0x22, 0x4f, 0x60, 0x68, 0x92, 0xa0, 0xc7, 0x6d, 0x7e, 0xb5, 0xcb, 0xf8, 0x02, 0x48,
+	0x61, 0x20, 0x49, 0x19, 0x47, 0x40, 0x5f, 0xc9, 0xc4, 0x63, 0x68, 0xa9, 0xc1, 0xc6, 0xa8, 0x55,
+	0x66, 0xaa, 0xd1, 0x56, 0x95, 0xb9, 0x0c, 0x83, 0x77, 0x50, 0xc6, 0x1b, 0x7c, 0x0c, 0xad, 0x17,
+	0xc9, 0xa5, 0xaa, 0xd5, 0x59, 0x4a, 0xb9, 0xfb, 0x22, 0xf1, 0xa9, 0x60, 0x57, 0x56, 0x53, 0x13,
+	0x91, 0x51, 0xab, 0x0c, 0x58, 0xa3, 0xad, 0x2a, 0x73, 0x39, 0xca, 0x77, 0x50, 0xc6, 0x28, 0x7f,
+	0x0a, 0xdd, 0x7c, 0xbe, 0x20, 0xd7, 0x95, 0xe0, 0xe2, 0xd0, 0x32, 0xba, 0xb1, 0xc4, 0xcf, 0x1d,
+	0xf8, 0x0a, 0x36, 0x94, 0x03, 0xff, 0x8b, 0x15, 0xf4, 0xe4, 0x87, 0xd0, 0x2b, 0xb7, 0xba, 0xfa,
+	0x8b, 0x59, 0x1e, 0xff, 0x46, 0x3d, 0xfd, 0x77, 0x0a, 0xff, 0x5b, 0xae, 0x91, 0x47, 0xd0, 0x2d,
+	0x9e, 0xde, 0x1b, 0x4b, 0x65, 0x69, 0xb5, 0xd2, 0x33, 0x58, 0x2f, 0x0f, 0x74, 0x17, 0xeb, 0x8d,
+	0x72, 0xa7, 0x97, 0xa6, 0x3f, 0x67, 0x8d, 0x7c, 0x0e, 0x6d, 0x3d, 0xbe, 0x10, 0x9d, 0xe4, 0xea,
+	0x14, 0x35, 0x7a, 0x6f, 0x81, 0x9b, 0x6b, 0x7e, 0x06, 0x4d, 0x9c, 0x5f, 0x2e, 0x3e, 0xf9, 0x9a,
+	0xd9, 0x28, 0x4d, 0x39, 0xce, 0x1a, 0xf9, 0x31, 0x74, 0x0e, 0x98, 0x50, 0xbf, 0xb0, 0x2e, 0xd3,
+	0xad, 0x4c, 0x3e, 0x58, 0x55, 0x5b, 0xaa, 0x09, 0xba, 0x58, 0x53, 0x87, 0x51, 0xed, 0x95, 0x10,
+	0x68, 0x1d, 0xd3, 0x77, 0x5c, 0xac, 0xac, 0x6f, 0x7e, 0xb1, 0x41, 0xc1, 0x52, 0x72, 0x00, 0x83,
+	0x2f, 0x83, 0xc8, 0x2f, 0x9e, 0x62, 0x63, 0x66, 0xa9, 0xb5, 0x18, 0xd9, 0xcb, 0x1b, 0x25, 0x43,
+	0xf7, 0xa1, 0xa5, 0x3a, 0x9b, 0x02, 0xec, 0xa5, 0x3e, 0x67, 0x19, 0x1d, 0x43, 0x05, 0xa1, 0xd2,
+	0x0b, 0xbc, 0xf4, 0x76, 0x2d, 0x2a, 0x7d, 0x0e, 0x83, 0xfd, 0x34, 0x4e, 0x4a, 0x2a, 0x6f, 0x8b,
+	0xab, 0x27, 0x6a, 0x9e, 0x2d, 0xe4, 0x38, 0x29, 0x4b, 0x8c, 0xee, 0x14, 0x78, 0x58, 0xf1, 0xd4,
+	0x3a, 0x6b, 0x4f, 0x87, 0x7f, 0x7b, 0xbd, 0x6d, 0xfd, 0xe3, 0xf5, 0xb6, 0xf5, 0xcf, 0xd7, 0xdb,
+	0xd6, 0x1f, 0xfe, 0xb5, 0xbd, 0xf6, 0xb2, 0x85, 0xff, 0xeb, 0x1f, 0xfd, 0x77, 0x00, 0x9d, 0x79,
+	0x3f, 0xc4, 0xc3, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NGTDClient is the client API for NGTD service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NGTDClient interface {
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	SearchByID(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	StreamSearch(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamSearchClient, error)
+	StreamSearchByID(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamSearchByIDClient, error)
+	MultiSearch(ctx context.Context, in *MultiSearchRequest, opts ...grpc.CallOption) (*MultiSearchResponse, error)
+	SearchRange(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (NGTD_SearchRangeClient, error)
+	SearchRangeByID(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (NGTD_SearchRangeByIDClient, error)
+	SearchByExamples(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	SearchByExpression(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error)
+	StreamInsert(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamInsertClient, error)
+	Upsert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*UpsertResponse, error)
+	Update(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*UpsertResponse, error)
+	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error)
+	StreamRemove(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamRemoveClient, error)
+	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error)
+	StreamGetObject(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamGetObjectClient, error)
+	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*Empty, error)
+	SaveIndex(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetDimension(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (*GetDimensionResponse, error)
+	ListIDs(ctx context.Context, in *ListIDsRequest, opts ...grpc.CallOption) (*ListIDsResponse, error)
+	Count(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	GetStats(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	Health(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+	Snapshot(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (NGTD_SnapshotClient, error)
+	FindDuplicates(ctx context.Context, in *DuplicatesRequest, opts ...grpc.CallOption) (NGTD_FindDuplicatesClient, error)
+	Reload(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateCollection(ctx context.Context, in *Collection, opts ...grpc.CallOption) (*Empty, error)
+	DropCollection(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListCollections(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+}
+
+type nGTDClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNGTDClient(cc *grpc.ClientConn) NGTDClient {
+	return &nGTDClient{cc}
+}
+
+func (c *nGTDClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) SearchByID(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/SearchByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) StreamSearch(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamSearchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[0], "/ngtd.NGTD/StreamSearch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nGTDStreamSearchClient{stream}
+	return x, nil
+}
+
+type NGTD_StreamSearchClient interface {
+	Send(*SearchRequest) error
+	Recv() (*SearchResponse, error)
+	grpc.ClientStream
+}
+
+type nGTDStreamSearchClient struct {
+	grpc.ClientStream
+}
+
+func (x *nGTDStreamSearchClient) Send(m *SearchRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *nGTDStreamSearchClient) Recv() (*SearchResponse, error) {
+	m := new(SearchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nGTDClient) StreamSearchByID(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamSearchByIDClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[1], "/ngtd.NGTD/StreamSearchByID", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nGTDStreamSearchByIDClient{stream}
+	return x, nil
+}
+
+type NGTD_StreamSearchByIDClient interface {
+	Send(*SearchRequest) error
+	Recv() (*SearchResponse, error)
+	grpc.ClientStream
+}
+
+type nGTDStreamSearchByIDClient struct {
+	grpc.ClientStream
+}
+
+func (x *nGTDStreamSearchByIDClient) Send(m *SearchRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *nGTDStreamSearchByIDClient) Recv() (*SearchResponse, error) {
+	m := new(SearchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nGTDClient) MultiSearch(ctx context.Context, in *MultiSearchRequest, opts ...grpc.CallOption) (*MultiSearchResponse, error) {
+	out := new(MultiSearchResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/MultiSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) SearchRange(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (NGTD_SearchRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[2], "/ngtd.NGTD/SearchRange", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nGTDSearchRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NGTD_SearchRangeClient interface {
+	Recv() (*SearchResponse, error)
+	grpc.ClientStream
+}
+
+type nGTDSearchRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *nGTDSearchRangeClient) Recv() (*SearchResponse, error) {
+	m := new(SearchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nGTDClient) SearchRangeByID(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (NGTD_SearchRangeByIDClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[3], "/ngtd.NGTD/SearchRangeByID", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nGTDSearchRangeByIDClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NGTD_SearchRangeByIDClient interface {
+	Recv() (*SearchResponse, error)
+	grpc.ClientStream
+}
+
+type nGTDSearchRangeByIDClient struct {
+	grpc.ClientStream
+}
+
+func (x *nGTDSearchRangeByIDClient) Recv() (*SearchResponse, error) {
+	m := new(SearchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nGTDClient) SearchByExamples(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/SearchByExamples", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) SearchByExpression(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/SearchByExpression", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error) {
+	out := new(InsertResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/Insert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) StreamInsert(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamInsertClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[4], "/ngtd.NGTD/StreamInsert", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nGTDStreamInsertClient{stream}
+	return x, nil
+}
+
+type NGTD_StreamInsertClient interface {
+	Send(*InsertRequest) error
+	Recv() (*InsertResponse, error)
+	grpc.ClientStream
+}
+
+type nGTDStreamInsertClient struct {
+	grpc.ClientStream
+}
+
+func (x *nGTDStreamInsertClient) Send(m *InsertRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *nGTDStreamInsertClient) Recv() (*InsertResponse, error) {
+	m := new(InsertResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nGTDClient) Upsert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*UpsertResponse, error) {
+	out := new(UpsertResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) Update(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*UpsertResponse, error) {
+	out := new(UpsertResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveResponse, error) {
+	out := new(RemoveResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/Remove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) StreamRemove(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamRemoveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[5], "/ngtd.NGTD/StreamRemove", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nGTDStreamRemoveClient{stream}
+	return x, nil
+}
+
+type NGTD_StreamRemoveClient interface {
+	Send(*RemoveRequest) error
+	Recv() (*RemoveResponse, error)
+	grpc.ClientStream
+}
+
+type nGTDStreamRemoveClient struct {
+	grpc.ClientStream
+}
+
+func (x *nGTDStreamRemoveClient) Send(m *RemoveRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *nGTDStreamRemoveClient) Recv() (*RemoveResponse, error) {
+	m := new(RemoveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nGTDClient) GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error) {
+	out := new(GetObjectResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/GetObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) StreamGetObject(ctx context.Context, opts ...grpc.CallOption) (NGTD_StreamGetObjectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[6], "/ngtd.NGTD/StreamGetObject", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nGTDStreamGetObjectClient{stream}
+	return x, nil
+}
+
+type NGTD_StreamGetObjectClient interface {
+	Send(*GetObjectRequest) error
+	Recv() (*GetObjectResponse, error)
+	grpc.ClientStream
+}
+
+type nGTDStreamGetObjectClient struct {
+	grpc.ClientStream
+}
+
+func (x *nGTDStreamGetObjectClient) Send(m *GetObjectRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *nGTDStreamGetObjectClient) Recv() (*GetObjectResponse, error) {
+	m := new(GetObjectResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nGTDClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/CreateIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) SaveIndex(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/SaveIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) GetDimension(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (*GetDimensionResponse, error) {
+	out := new(GetDimensionResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/GetDimension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) ListIDs(ctx context.Context, in *ListIDsRequest, opts ...grpc.CallOption) (*ListIDsResponse, error) {
+	out := new(ListIDsResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/ListIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) Count(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/Count", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) GetStats(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) Health(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) Snapshot(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (NGTD_SnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[7], "/ngtd.NGTD/Snapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nGTDSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NGTD_SnapshotClient interface {
+	Recv() (*SnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type nGTDSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *nGTDSnapshotClient) Recv() (*SnapshotResponse, error) {
+	m := new(SnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nGTDClient) FindDuplicates(ctx context.Context, in *DuplicatesRequest, opts ...grpc.CallOption) (NGTD_FindDuplicatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NGTD_serviceDesc.Streams[8], "/ngtd.NGTD/FindDuplicates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nGTDFindDuplicatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NGTD_FindDuplicatesClient interface {
+	Recv() (*DuplicatesResponse, error)
+	grpc.ClientStream
+}
+
+type nGTDFindDuplicatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *nGTDFindDuplicatesClient) Recv() (*DuplicatesResponse, error) {
+	m := new(DuplicatesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nGTDClient) Reload(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/Reload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) CreateCollection(ctx context.Context, in *Collection, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/CreateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) DropCollection(ctx context.Context, in *CollectionRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/DropCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nGTDClient) ListCollections(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/ngtd.NGTD/ListCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NGTDServer is the server API for NGTD service.
+type NGTDServer interface {
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	SearchByID(context.Context, *SearchRequest) (*SearchResponse, error)
+	StreamSearch(NGTD_StreamSearchServer) error
+	StreamSearchByID(NGTD_StreamSearchByIDServer) error
+	MultiSearch(context.Context, *MultiSearchRequest) (*MultiSearchResponse, error)
+	SearchRange(*SearchRequest, NGTD_SearchRangeServer) error
+	SearchRangeByID(*SearchRequest, NGTD_SearchRangeByIDServer) error
+	SearchByExamples(context.Context, *SearchRequest) (*SearchResponse, error)
+	SearchByExpression(context.Context, *SearchRequest) (*SearchResponse, error)
+	Insert(context.Context, *InsertRequest) (*InsertResponse, error)
+	StreamInsert(NGTD_StreamInsertServer) error
+	Upsert(context.Context, *InsertRequest) (*UpsertResponse, error)
+	Update(context.Context, *InsertRequest) (*UpsertResponse, error)
+	Remove(context.Context, *RemoveRequest) (*RemoveResponse, error)
+	StreamRemove(NGTD_StreamRemoveServer) error
+	GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error)
+	StreamGetObject(NGTD_StreamGetObjectServer) error
+	CreateIndex(context.Context, *CreateIndexRequest) (*Empty, error)
+	SaveIndex(context.Context, *CollectionRequest) (*Empty, error)
+	GetDimension(context.Context, *CollectionRequest) (*GetDimensionResponse, error)
+	ListIDs(context.Context, *ListIDsRequest) (*ListIDsResponse, error)
+	Count(context.Context, *CollectionRequest) (*CountResponse, error)
+	GetStats(context.Context, *CollectionRequest) (*StatsResponse, error)
+	Health(context.Context, *CollectionRequest) (*HealthResponse, error)
+	Snapshot(*CollectionRequest, NGTD_SnapshotServer) error
+	FindDuplicates(*DuplicatesRequest, NGTD_FindDuplicatesServer) error
+	Reload(context.Context, *ReloadRequest) (*Empty, error)
+	CreateCollection(context.Context, *Collection) (*Empty, error)
+	DropCollection(context.Context, *CollectionRequest) (*Empty, error)
+	ListCollections(context.Context, *Empty) (*ListCollectionsResponse, error)
+}
+
+func RegisterNGTDServer(s *grpc.Server, srv NGTDServer) {
+	s.RegisterService(&_NGTD_serviceDesc, srv)
+}
+
+func _NGTD_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_SearchByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).SearchByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/SearchByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).SearchByID(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_StreamSearch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NGTDServer).StreamSearch(&nGTDStreamSearchServer{stream})
+}
+
+type NGTD_StreamSearchServer interface {
+	Send(*SearchResponse) error
+	Recv() (*SearchRequest, error)
+	grpc.ServerStream
+}
+
+type nGTDStreamSearchServer struct {
+	grpc.ServerStream
+}
+
+func (x *nGTDStreamSearchServer) Send(m *SearchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *nGTDStreamSearchServer) Recv() (*SearchRequest, error) {
+	m := new(SearchRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _NGTD_StreamSearchByID_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NGTDServer).StreamSearchByID(&nGTDStreamSearchByIDServer{stream})
+}
+
+type NGTD_StreamSearchByIDServer interface {
+	Send(*SearchResponse) error
+	Recv() (*SearchRequest, error)
+	grpc.ServerStream
+}
+
+type nGTDStreamSearchByIDServer struct {
+	grpc.ServerStream
+}
+
+func (x *nGTDStreamSearchByIDServer) Send(m *SearchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *nGTDStreamSearchByIDServer) Recv() (*SearchRequest, error) {
+	m := new(SearchRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _NGTD_MultiSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).MultiSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/MultiSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).MultiSearch(ctx, req.(*MultiSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_SearchRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NGTDServer).SearchRange(m, &nGTDSearchRangeServer{stream})
+}
+
+type NGTD_SearchRangeServer interface {
+	Send(*SearchResponse) error
+	grpc.ServerStream
+}
+
+type nGTDSearchRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *nGTDSearchRangeServer) Send(m *SearchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NGTD_SearchRangeByID_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NGTDServer).SearchRangeByID(m, &nGTDSearchRangeByIDServer{stream})
+}
+
+type NGTD_SearchRangeByIDServer interface {
+	Send(*SearchResponse) error
+	grpc.ServerStream
+}
+
+type nGTDSearchRangeByIDServer struct {
+	grpc.ServerStream
+}
+
+func (x *nGTDSearchRangeByIDServer) Send(m *SearchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NGTD_SearchByExamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).SearchByExamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/SearchByExamples",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).SearchByExamples(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_SearchByExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).SearchByExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/SearchByExpression",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).SearchByExpression(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).Insert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/Insert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).Insert(ctx, req.(*InsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_StreamInsert_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NGTDServer).StreamInsert(&nGTDStreamInsertServer{stream})
+}
+
+type NGTD_StreamInsertServer interface {
+	Send(*InsertResponse) error
+	Recv() (*InsertRequest, error)
+	grpc.ServerStream
+}
+
+type nGTDStreamInsertServer struct {
+	grpc.ServerStream
+}
+
+func (x *nGTDStreamInsertServer) Send(m *InsertResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *nGTDStreamInsertServer) Recv() (*InsertRequest, error) {
+	m := new(InsertRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _NGTD_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/Upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).Upsert(ctx, req.(*InsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).Update(ctx, req.(*InsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).Remove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).Remove(ctx, req.(*RemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_StreamRemove_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NGTDServer).StreamRemove(&nGTDStreamRemoveServer{stream})
+}
+
+type NGTD_StreamRemoveServer interface {
+	Send(*RemoveResponse) error
+	Recv() (*RemoveRequest, error)
+	grpc.ServerStream
+}
+
+type nGTDStreamRemoveServer struct {
+	grpc.ServerStream
+}
+
+func (x *nGTDStreamRemoveServer) Send(m *RemoveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *nGTDStreamRemoveServer) Recv() (*RemoveRequest, error) {
+	m := new(RemoveRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _NGTD_GetObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).GetObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/GetObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).GetObject(ctx, req.(*GetObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_StreamGetObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NGTDServer).StreamGetObject(&nGTDStreamGetObjectServer{stream})
+}
+
+type NGTD_StreamGetObjectServer interface {
+	Send(*GetObjectResponse) error
+	Recv() (*GetObjectRequest, error)
+	grpc.ServerStream
+}
+
+type nGTDStreamGetObjectServer struct {
+	grpc.ServerStream
+}
+
+func (x *nGTDStreamGetObjectServer) Send(m *GetObjectResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *nGTDStreamGetObjectServer) Recv() (*GetObjectRequest, error) {
+	m := new(GetObjectRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _NGTD_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).CreateIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/CreateIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).CreateIndex(ctx, req.(*CreateIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_SaveIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).SaveIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/SaveIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).SaveIndex(ctx, req.(*CollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_GetDimension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).GetDimension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/GetDimension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).GetDimension(ctx, req.(*CollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_ListIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).ListIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/ListIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).ListIDs(ctx, req.(*ListIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/Count",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).Count(ctx, req.(*CollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).GetStats(ctx, req.(*CollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).Health(ctx, req.(*CollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_Snapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CollectionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NGTDServer).Snapshot(m, &nGTDSnapshotServer{stream})
+}
+
+type NGTD_SnapshotServer interface {
+	Send(*SnapshotResponse) error
+	grpc.ServerStream
+}
+
+type nGTDSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *nGTDSnapshotServer) Send(m *SnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NGTD_FindDuplicates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DuplicatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NGTDServer).FindDuplicates(m, &nGTDFindDuplicatesServer{stream})
+}
+
+type NGTD_FindDuplicatesServer interface {
+	Send(*DuplicatesResponse) error
+	grpc.ServerStream
+}
+
+type nGTDFindDuplicatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *nGTDFindDuplicatesServer) Send(m *DuplicatesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NGTD_Reload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).Reload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/Reload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).Reload(ctx, req.(*ReloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Collection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/CreateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).CreateCollection(ctx, req.(*Collection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_DropCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).DropCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/DropCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).DropCollection(ctx, req.(*CollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NGTD_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NGTDServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ngtd.NGTD/ListCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NGTDServer).ListCollections(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NGTD_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ngtd.NGTD",
+	HandlerType: (*NGTDServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _NGTD_Search_Handler,
+		},
+		{
+			MethodName: "SearchByID",
+			Handler:    _NGTD_SearchByID_Handler,
+		},
+		{
+			MethodName: "MultiSearch",
+			Handler:    _NGTD_MultiSearch_Handler,
+		},
+		{
+			MethodName: "SearchByExamples",
+			Handler:    _NGTD_SearchByExamples_Handler,
+		},
+		{
+			MethodName: "SearchByExpression",
+			Handler:    _NGTD_SearchByExpression_Handler,
+		},
+		{
+			MethodName: "Insert",
+			Handler:    _NGTD_Insert_Handler,
+		},
+		{
+			MethodName: "Upsert",
+			Handler:    _NGTD_Upsert_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _NGTD_Update_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _NGTD_Remove_Handler,
+		},
+		{
+			MethodName: "GetObject",
+			Handler:    _NGTD_GetObject_Handler,
+		},
+		{
+			MethodName: "CreateIndex",
+			Handler:    _NGTD_CreateIndex_Handler,
+		},
+		{
+			MethodName: "SaveIndex",
+			Handler:    _NGTD_SaveIndex_Handler,
+		},
+		{
+			MethodName: "GetDimension",
+			Handler:    _NGTD_GetDimension_Handler,
+		},
+		{
+			MethodName: "ListIDs",
+			Handler:    _NGTD_ListIDs_Handler,
+		},
+		{
+			MethodName: "Count",
+			Handler:    _NGTD_Count_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _NGTD_GetStats_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _NGTD_Health_Handler,
+		},
+		{
+			MethodName: "Reload",
+			Handler:    _NGTD_Reload_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _NGTD_CreateCollection_Handler,
+		},
+		{
+			MethodName: "DropCollection",
+			Handler:    _NGTD_DropCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _NGTD_ListCollections_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSearch",
+			Handler:       _NGTD_StreamSearch_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamSearchByID",
+			Handler:       _NGTD_StreamSearchByID_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SearchRange",
+			Handler:       _NGTD_SearchRange_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SearchRangeByID",
+			Handler:       _NGTD_SearchRangeByID_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamInsert",
+			Handler:       _NGTD_StreamInsert_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamRemove",
+			Handler:       _NGTD_StreamRemove_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamGetObject",
+			Handler:       _NGTD_StreamGetObject_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Snapshot",
+			Handler:       _NGTD_Snapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindDuplicates",
+			Handler:       _NGTD_FindDuplicates_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/ngtd.proto",
+}
+
+func (m *Empty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vector) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Vector)*8))
+		for _, num := range m.Vector {
+			f1 := math.Float64bits(float64(num))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f1))
+			i += 8
+		}
+	}
+	if len(m.Id) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Collection) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Collection)))
+		i += copy(dAtA[i:], m.Collection)
+	}
+	if m.WithMeta {
+		dAtA[i] = 0x20
+		i++
+		if m.WithMeta {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Filter) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Filter)))
+		i += copy(dAtA[i:], m.Filter)
+	}
+	if m.MaxCandidates != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.MaxCandidates))
+	}
+	if m.Radius != 0 {
+		dAtA[i] = 0x3d
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Radius))))
+		i += 4
+	}
+	if m.ExcludeSelf {
+		dAtA[i] = 0x40
+		i++
+		if m.ExcludeSelf {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.ExcludeIds) > 0 {
+		for _, b := range m.ExcludeIds {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintNgtd(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Size_))
+	}
+	if m.Epsilon != 0 {
+		dAtA[i] = 0x5d
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Epsilon))))
+		i += 4
+	}
+	if m.RerankCandidates != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.RerankCandidates))
+	}
+	if len(m.RerankMetric) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.RerankMetric)))
+		i += copy(dAtA[i:], m.RerankMetric)
+	}
+	if m.WithStats {
+		dAtA[i] = 0x70
+		i++
+		if m.WithStats {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.ScoreMode) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.ScoreMode)))
+		i += copy(dAtA[i:], m.ScoreMode)
+	}
+	if len(m.Positive) > 0 {
+		for _, msg := range m.Positive {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintNgtd(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Negative) > 0 {
+		for _, msg := range m.Negative {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintNgtd(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Terms) > 0 {
+		for _, msg := range m.Terms {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintNgtd(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.HasRadius {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.HasRadius {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Term) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Term) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Vector) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Vector)*8))
+		for _, num := range m.Vector {
+			f2 := math.Float64bits(float64(num))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f2))
+			i += 8
+		}
+	}
+	if m.Weight != 0 {
+		dAtA[i] = 0x1d
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Weight))))
+		i += 4
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Example) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Example) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Weight != 0 {
+		dAtA[i] = 0x15
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Weight))))
+		i += 4
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SearchStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SearchMs != 0 {
+		dAtA[i] = 0x9
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SearchMs))))
+		i += 8
+	}
+	if m.RerankMs != 0 {
+		dAtA[i] = 0x11
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RerankMs))))
+		i += 8
+	}
+	if m.TotalMs != 0 {
+		dAtA[i] = 0x19
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TotalMs))))
+		i += 8
+	}
+	if m.Candidates != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Candidates))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ObjectDistance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectDistance) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Meta) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Meta)))
+		i += copy(dAtA[i:], m.Meta)
+	}
+	if m.Distance != 0 {
+		dAtA[i] = 0x55
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Distance))))
+		i += 4
+	}
+	if m.Score != 0 {
+		dAtA[i] = 0x5d
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Score))))
+		i += 4
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Result) > 0 {
+		for _, msg := range m.Result {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNgtd(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Stats != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Stats.Size()))
+		n3, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.ScoreMode) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.ScoreMode)))
+		i += copy(dAtA[i:], m.ScoreMode)
+	}
+	if m.Truncated {
+		dAtA[i] = 0x20
+		i++
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *MultiSearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiSearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, msg := range m.Requests {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNgtd(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Collection) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Collection)))
+		i += copy(dAtA[i:], m.Collection)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *MultiSearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiSearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, msg := range m.Responses {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNgtd(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InsertRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vector) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Vector)*8))
+		for _, num := range m.Vector {
+			f4 := math.Float64bits(float64(num))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f4))
+			i += 8
+		}
+	}
+	if len(m.Id) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Collection) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Collection)))
+		i += copy(dAtA[i:], m.Collection)
+	}
+	if len(m.Meta) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Meta)))
+		i += copy(dAtA[i:], m.Meta)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InsertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *UpsertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpsertResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Replaced {
+		dAtA[i] = 0x8
+		i++
+		if m.Replaced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RemoveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Collection) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Collection)))
+		i += copy(dAtA[i:], m.Collection)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RemoveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CreateIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PoolSize != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.PoolSize))
+	}
+	if len(m.Collection) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Collection)))
+		i += copy(dAtA[i:], m.Collection)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CollectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Collection) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Collection)))
+		i += copy(dAtA[i:], m.Collection)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetDimensionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDimensionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Dimension != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Dimension))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Collection) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Collection)))
+		i += copy(dAtA[i:], m.Collection)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Vector) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Vector)*4))
+		for _, num := range m.Vector {
+			f5 := math.Float32bits(float32(num))
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f5))
+			i += 4
+		}
+	}
+	if len(m.Meta) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Meta)))
+		i += copy(dAtA[i:], m.Meta)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListIDsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListIDsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cursor) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Cursor)))
+		i += copy(dAtA[i:], m.Cursor)
+	}
+	if len(m.Prefix) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Limit))
+	}
+	if len(m.Collection) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Collection)))
+		i += copy(dAtA[i:], m.Collection)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListIDsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListIDsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, b := range m.Ids {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNgtd(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Next) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Next)))
+		i += copy(dAtA[i:], m.Next)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Objects != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Objects))
+	}
+	if m.NgtObjects != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.NgtObjects))
+	}
+	if m.IndexedObjects != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.IndexedObjects))
+	}
+	if m.UnindexedObjects != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.UnindexedObjects))
+	}
+	if m.LastCreateIndex != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.LastCreateIndex))
+	}
+	if m.CreateIndexSeconds != 0 {
+		dAtA[i] = 0x31
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CreateIndexSeconds))))
+		i += 8
+	}
+	if m.LastSaveIndex != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.LastSaveIndex))
+	}
+	if m.SaveIndexSeconds != 0 {
+		dAtA[i] = 0x41
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SaveIndexSeconds))))
+		i += 8
+	}
+	if m.IndexSize != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.IndexSize))
+	}
+	if len(m.KvsType) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.KvsType)))
+		i += copy(dAtA[i:], m.KvsType)
+	}
+	if m.UptimeSeconds != 0 {
+		dAtA[i] = 0x59
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.UptimeSeconds))))
+		i += 8
+	}
+	if m.AutoIndex != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.AutoIndex.Size()))
+		n6, err := m.AutoIndex.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.UnsavedObjects != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.UnsavedObjects))
+	}
+	if m.AutoSave != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.AutoSave.Size()))
+		n7, err := m.AutoSave.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AutoIndexStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoIndexStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pending != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Pending))
+	}
+	if m.IntervalSeconds != 0 {
+		dAtA[i] = 0x11
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.IntervalSeconds))))
+		i += 8
+	}
+	if m.PoolSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.PoolSize))
+	}
+	if m.Runs != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Runs))
+	}
+	if m.LastRun != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.LastRun))
+	}
+	if m.LastRunSeconds != 0 {
+		dAtA[i] = 0x31
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LastRunSeconds))))
+		i += 8
+	}
+	if len(m.LastError) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.LastError)))
+		i += copy(dAtA[i:], m.LastError)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AutoSaveStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoSaveStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Unsaved != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Unsaved))
+	}
+	if m.IntervalSeconds != 0 {
+		dAtA[i] = 0x11
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.IntervalSeconds))))
+		i += 8
+	}
+	if m.Runs != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Runs))
+	}
+	if m.LastRun != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.LastRun))
+	}
+	if m.LastRunSeconds != 0 {
+		dAtA[i] = 0x29
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LastRunSeconds))))
+		i += 8
+	}
+	if len(m.LastError) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.LastError)))
+		i += copy(dAtA[i:], m.LastError)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Healthy {
+		dAtA[i] = 0x8
+		i++
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.LastSaveIndex != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.LastSaveIndex))
+	}
+	if m.UnsavedObjects != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.UnsavedObjects))
+	}
+	if m.AutoSave != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.AutoSave.Size()))
+		n8, err := m.AutoSave.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Index)))
+		i += copy(dAtA[i:], m.Index)
+	}
+	if len(m.DatabasePath) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.DatabasePath)))
+		i += copy(dAtA[i:], m.DatabasePath)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DuplicatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DuplicatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		dAtA[i] = 0xd
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Threshold))))
+		i += 4
+	}
+	if m.Epsilon != 0 {
+		dAtA[i] = 0x15
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Epsilon))))
+		i += 4
+	}
+	if len(m.Collection) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Collection)))
+		i += copy(dAtA[i:], m.Collection)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DuplicatePair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DuplicatePair) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id1) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id1)))
+		i += copy(dAtA[i:], m.Id1)
+	}
+	if len(m.Id2) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Id2)))
+		i += copy(dAtA[i:], m.Id2)
+	}
+	if m.Distance != 0 {
+		dAtA[i] = 0x1d
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Distance))))
+		i += 4
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DuplicatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DuplicatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, msg := range m.Pairs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNgtd(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Done != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Done))
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Total))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Collection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Collection) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Dimension != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(m.Dimension))
+	}
+	if len(m.DistanceType) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.DistanceType)))
+		i += copy(dAtA[i:], m.DistanceType)
+	}
+	if len(m.ObjectType) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintNgtd(dAtA, i, uint64(len(m.ObjectType)))
+		i += copy(dAtA[i:], m.ObjectType)
+	}
+	if m.Normalize {
+		dAtA[i] = 0x28
+		i++
+		if m.Normalize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListCollectionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCollectionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Collections) > 0 {
+		for _, msg := range m.Collections {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNgtd(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintNgtd(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Empty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vector) > 0 {
+		n += 1 + sovNgtd(uint64(len(m.Vector)*8)) + len(m.Vector)*8
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.WithMeta {
+		n += 2
+	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.MaxCandidates != 0 {
+		n += 1 + sovNgtd(uint64(m.MaxCandidates))
+	}
+	if m.Radius != 0 {
+		n += 5
+	}
+	if m.ExcludeSelf {
+		n += 2
+	}
+	if len(m.ExcludeIds) > 0 {
+		for _, b := range m.ExcludeIds {
+			l = len(b)
+			n += 1 + l + sovNgtd(uint64(l))
+		}
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovNgtd(uint64(m.Size_))
+	}
+	if m.Epsilon != 0 {
+		n += 5
+	}
+	if m.RerankCandidates != 0 {
+		n += 1 + sovNgtd(uint64(m.RerankCandidates))
+	}
+	l = len(m.RerankMetric)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.WithStats {
+		n += 2
+	}
+	l = len(m.ScoreMode)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if len(m.Positive) > 0 {
+		for _, e := range m.Positive {
+			l = e.Size()
+			n += 2 + l + sovNgtd(uint64(l))
+		}
+	}
+	if len(m.Negative) > 0 {
+		for _, e := range m.Negative {
+			l = e.Size()
+			n += 2 + l + sovNgtd(uint64(l))
+		}
+	}
+	if len(m.Terms) > 0 {
+		for _, e := range m.Terms {
+			l = e.Size()
+			n += 2 + l + sovNgtd(uint64(l))
+		}
+	}
+	if m.HasRadius {
+		n += 3
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Term) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if len(m.Vector) > 0 {
+		n += 1 + sovNgtd(uint64(len(m.Vector)*8)) + len(m.Vector)*8
+	}
+	if m.Weight != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Example) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SearchMs != 0 {
+		n += 9
+	}
+	if m.RerankMs != 0 {
+		n += 9
+	}
+	if m.TotalMs != 0 {
+		n += 9
+	}
+	if m.Candidates != 0 {
+		n += 1 + sovNgtd(uint64(m.Candidates))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ObjectDistance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.Distance != 0 {
+		n += 5
+	}
+	if m.Score != 0 {
+		n += 5
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 2 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Result) > 0 {
+		for _, e := range m.Result {
+			l = e.Size()
+			n += 1 + l + sovNgtd(uint64(l))
+		}
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	l = len(m.ScoreMode)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 2 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MultiSearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovNgtd(uint64(l))
+		}
+	}
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MultiSearchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovNgtd(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InsertRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vector) > 0 {
+		n += 1 + sovNgtd(uint64(len(m.Vector)*8)) + len(m.Vector)*8
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InsertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 2 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpsertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Replaced {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 2 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 2 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolSize != 0 {
+		n += 1 + sovNgtd(uint64(m.PoolSize))
+	}
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CollectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDimensionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dimension != 0 {
+		n += 1 + sovNgtd(uint64(m.Dimension))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetObjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if len(m.Vector) > 0 {
+		n += 1 + sovNgtd(uint64(len(m.Vector)*4)) + len(m.Vector)*4
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 2 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListIDsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovNgtd(uint64(m.Limit))
+	}
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListIDsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, b := range m.Ids {
+			l = len(b)
+			n += 1 + l + sovNgtd(uint64(l))
+		}
+	}
+	l = len(m.Next)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovNgtd(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Objects != 0 {
+		n += 1 + sovNgtd(uint64(m.Objects))
+	}
+	if m.NgtObjects != 0 {
+		n += 1 + sovNgtd(uint64(m.NgtObjects))
+	}
+	if m.IndexedObjects != 0 {
+		n += 1 + sovNgtd(uint64(m.IndexedObjects))
+	}
+	if m.UnindexedObjects != 0 {
+		n += 1 + sovNgtd(uint64(m.UnindexedObjects))
+	}
+	if m.LastCreateIndex != 0 {
+		n += 1 + sovNgtd(uint64(m.LastCreateIndex))
+	}
+	if m.CreateIndexSeconds != 0 {
+		n += 9
+	}
+	if m.LastSaveIndex != 0 {
+		n += 1 + sovNgtd(uint64(m.LastSaveIndex))
+	}
+	if m.SaveIndexSeconds != 0 {
+		n += 9
+	}
+	if m.IndexSize != 0 {
+		n += 1 + sovNgtd(uint64(m.IndexSize))
+	}
+	l = len(m.KvsType)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.UptimeSeconds != 0 {
+		n += 9
+	}
+	if m.AutoIndex != nil {
+		l = m.AutoIndex.Size()
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.UnsavedObjects != 0 {
+		n += 1 + sovNgtd(uint64(m.UnsavedObjects))
+	}
+	if m.AutoSave != nil {
+		l = m.AutoSave.Size()
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AutoIndexStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pending != 0 {
+		n += 1 + sovNgtd(uint64(m.Pending))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 9
+	}
+	if m.PoolSize != 0 {
+		n += 1 + sovNgtd(uint64(m.PoolSize))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovNgtd(uint64(m.Runs))
+	}
+	if m.LastRun != 0 {
+		n += 1 + sovNgtd(uint64(m.LastRun))
+	}
+	if m.LastRunSeconds != 0 {
+		n += 9
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AutoSaveStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Unsaved != 0 {
+		n += 1 + sovNgtd(uint64(m.Unsaved))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 9
+	}
+	if m.Runs != 0 {
+		n += 1 + sovNgtd(uint64(m.Runs))
+	}
+	if m.LastRun != 0 {
+		n += 1 + sovNgtd(uint64(m.LastRun))
+	}
+	if m.LastRunSeconds != 0 {
+		n += 9
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Healthy {
+		n += 2
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovNgtd(uint64(l))
+		}
+	}
+	if m.LastSaveIndex != 0 {
+		n += 1 + sovNgtd(uint64(m.LastSaveIndex))
+	}
+	if m.UnsavedObjects != 0 {
+		n += 1 + sovNgtd(uint64(m.UnsavedObjects))
+	}
+	if m.AutoSave != nil {
+		l = m.AutoSave.Size()
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	l = len(m.DatabasePath)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DuplicatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 5
+	}
+	if m.Epsilon != 0 {
+		n += 5
+	}
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DuplicatePair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id1)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	l = len(m.Id2)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.Distance != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DuplicatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovNgtd(uint64(l))
+		}
+	}
+	if m.Done != 0 {
+		n += 1 + sovNgtd(uint64(m.Done))
+	}
+	if m.Total != 0 {
+		n += 1 + sovNgtd(uint64(m.Total))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 2 + l + sovNgtd(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Collection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.Dimension != 0 {
+		n += 1 + sovNgtd(uint64(m.Dimension))
+	}
+	l = len(m.DistanceType)
+	if l > 0 {
 		n += 1 + l + sovNgtd(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.ObjectType)
+	if l > 0 {
+		n += 1 + l + sovNgtd(uint64(l))
+	}
+	if m.Normalize {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCollectionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Collections) > 0 {
+		for _, e := range m.Collections {
+			l = e.Size()
+			n += 1 + l + sovNgtd(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovNgtd(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozNgtd(x uint64) (n int) {
+	return sovNgtd(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Empty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Vector = append(m.Vector, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNgtd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNgtd
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNgtd
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Vector) == 0 {
+					m.Vector = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Vector = append(m.Vector, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vector", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithMeta", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithMeta = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCandidates", wireType)
+			}
+			m.MaxCandidates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCandidates |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Radius", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Radius = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeSelf", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeSelf = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludeIds = append(m.ExcludeIds, make([]byte, postIndex-iNdEx))
+			copy(m.ExcludeIds[len(m.ExcludeIds)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Epsilon = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RerankCandidates", wireType)
+			}
+			m.RerankCandidates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RerankCandidates |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RerankMetric", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RerankMetric = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithStats", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithStats = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScoreMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positive = append(m.Positive, &Example{})
+			if err := m.Positive[len(m.Positive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Negative", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Negative = append(m.Negative, &Example{})
+			if err := m.Negative[len(m.Negative)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Terms = append(m.Terms, &Term{})
+			if err := m.Terms[len(m.Terms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRadius", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasRadius = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Term) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Term: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Term: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Vector = append(m.Vector, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNgtd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNgtd
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNgtd
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Vector) == 0 {
+					m.Vector = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Vector = append(m.Vector, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vector", wireType)
+			}
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Weight = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Example) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Example: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Example: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Weight = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SearchMs = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RerankMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RerankMs = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TotalMs = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			m.Candidates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Candidates |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectDistance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectDistance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectDistance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = append(m.Meta[:0], dAtA[iNdEx:postIndex]...)
+			if m.Meta == nil {
+				m.Meta = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distance", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Distance = float32(math.Float32frombits(v))
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Score = float32(math.Float32frombits(v))
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result, &ObjectDistance{})
+			if err := m.Result[len(m.Result)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &SearchStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScoreMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiSearchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiSearchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &SearchRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiSearchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiSearchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &SearchResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Vector = append(m.Vector, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNgtd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNgtd
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNgtd
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Vector) == 0 {
+					m.Vector = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Vector = append(m.Vector, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vector", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = append(m.Meta[:0], dAtA[iNdEx:postIndex]...)
+			if m.Meta == nil {
+				m.Meta = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpsertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpsertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpsertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replaced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replaced = bool(v != 0)
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSize", wireType)
+			}
+			m.PoolSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDimensionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDimensionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDimensionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dimension", wireType)
+			}
+			m.Dimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dimension |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetObjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetObjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.Vector = append(m.Vector, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNgtd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNgtd
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNgtd
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.Vector) == 0 {
+					m.Vector = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.Vector = append(m.Vector, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vector", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = append(m.Meta[:0], dAtA[iNdEx:postIndex]...)
+			if m.Meta == nil {
+				m.Meta = []byte{}
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListIDsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListIDsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListIDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListIDsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListIDsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListIDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, make([]byte, postIndex-iNdEx))
+			copy(m.Ids[len(m.Ids)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Next = append(m.Next[:0], dAtA[iNdEx:postIndex]...)
+			if m.Next == nil {
+				m.Next = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *GetObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovNgtd(uint64(l))
-	}
-	if len(m.Vector) > 0 {
-		n += 1 + sovNgtd(uint64(len(m.Vector)*4)) + len(m.Vector)*4
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 2 + l + sovNgtd(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func sovNgtd(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *CountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-func sozNgtd(x uint64) (n int) {
-	return sovNgtd(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Empty) Unmarshal(dAtA []byte) error {
+func (m *StatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1985,25 +9256,314 @@ func (m *Empty) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowNgtd
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			m.Objects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Objects |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NgtObjects", wireType)
+			}
+			m.NgtObjects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NgtObjects |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedObjects", wireType)
+			}
+			m.IndexedObjects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexedObjects |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnindexedObjects", wireType)
+			}
+			m.UnindexedObjects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnindexedObjects |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCreateIndex", wireType)
+			}
+			m.LastCreateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCreateIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateIndexSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CreateIndexSeconds = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSaveIndex", wireType)
+			}
+			m.LastSaveIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSaveIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaveIndexSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SaveIndexSeconds = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexSize", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.IndexSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KvsType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KvsType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.UptimeSeconds = float64(math.Float64frombits(v))
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoIndex == nil {
+				m.AutoIndex = &AutoIndexStatus{}
+			}
+			if err := m.AutoIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsavedObjects", wireType)
+			}
+			m.UnsavedObjects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnsavedObjects |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSave", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoSave == nil {
+				m.AutoSave = &AutoSaveStatus{}
+			}
+			if err := m.AutoSave.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNgtd(dAtA[iNdEx:])
@@ -2013,6 +9573,9 @@ func (m *Empty) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthNgtd
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2026,7 +9589,7 @@ func (m *Empty) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SearchRequest) Unmarshal(dAtA []byte) error {
+func (m *AutoIndexStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2041,7 +9604,7 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2049,68 +9612,85 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SearchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoIndexStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoIndexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.Vector = append(m.Vector, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowNgtd
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthNgtd
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.IntervalSeconds = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSize", wireType)
+			}
+			m.PoolSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.Vector) == 0 {
-					m.Vector = make([]float64, 0, elementCount)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.Vector = append(m.Vector, v2)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			m.Runs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vector", wireType)
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRun", wireType)
 			}
-			var byteLen int
+			m.LastRun = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNgtd
@@ -2120,28 +9700,27 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.LastRun |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthNgtd
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRunSeconds", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LastRunSeconds = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
-			m.Size_ = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNgtd
@@ -2151,22 +9730,24 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= (int32(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Epsilon = float32(math.Float32frombits(v))
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNgtd(dAtA[iNdEx:])
@@ -2176,6 +9757,9 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthNgtd
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2189,7 +9773,7 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectDistance) Unmarshal(dAtA []byte) error {
+func (m *AutoSaveStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2204,7 +9788,7 @@ func (m *ObjectDistance) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2212,17 +9796,17 @@ func (m *ObjectDistance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectDistance: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoSaveStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectDistance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoSaveStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsaved", wireType)
 			}
-			var byteLen int
+			m.Unsaved = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNgtd
@@ -2232,37 +9816,74 @@ func (m *ObjectDistance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Unsaved |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthNgtd
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.IntervalSeconds = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Distance", wireType)
+			m.Runs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRun", wireType)
+			}
+			m.LastRun = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRun |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRunSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Distance = float32(math.Float32frombits(v))
-		case 99:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LastRunSeconds = float64(math.Float64frombits(v))
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2274,7 +9895,7 @@ func (m *ObjectDistance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2284,10 +9905,13 @@ func (m *ObjectDistance) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthNgtd
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2298,6 +9922,9 @@ func (m *ObjectDistance) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthNgtd
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2311,7 +9938,7 @@ func (m *ObjectDistance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SearchResponse) Unmarshal(dAtA []byte) error {
+func (m *HealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2326,7 +9953,7 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2334,17 +9961,17 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SearchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNgtd
@@ -2354,26 +9981,15 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNgtd
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = append(m.Result, &ObjectDistance{})
-			if err := m.Result[len(m.Result)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 99:
+			m.Healthy = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2385,7 +10001,7 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2395,116 +10011,145 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthNgtd
 			}
 			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNgtd(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if postIndex < 0 {
 				return ErrInvalidLengthNgtd
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InsertRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNgtd
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSaveIndex", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.LastSaveIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSaveIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsavedObjects", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InsertRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
+			m.UnsavedObjects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.Vector = append(m.Vector, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowNgtd
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnsavedObjects |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthNgtd
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSave", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.Vector) == 0 {
-					m.Vector = make([]float64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.Vector = append(m.Vector, v2)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vector", wireType)
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoSave == nil {
+				m.AutoSave = &AutoSaveStatus{}
+			}
+			if err := m.AutoSave.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNgtd(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNgtd
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2516,7 +10161,7 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2525,12 +10170,15 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthNgtd
 			}
 			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2542,6 +10190,9 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthNgtd
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2555,7 +10206,7 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertResponse) Unmarshal(dAtA []byte) error {
+func (m *ReloadRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2570,7 +10221,7 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2578,15 +10229,15 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReloadRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 99:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2598,7 +10249,7 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2608,10 +10259,45 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthNgtd
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabasePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabasePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2622,6 +10308,9 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthNgtd
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2635,7 +10324,7 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
+func (m *DuplicatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2650,7 +10339,7 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2658,17 +10347,39 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DuplicatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DuplicatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Threshold = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Epsilon = float32(math.Float32frombits(v))
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNgtd
@@ -2678,22 +10389,23 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthNgtd
 			}
-			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
-			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2704,6 +10416,9 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthNgtd
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2717,7 +10432,7 @@ func (m *RemoveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
+func (m *DuplicatePair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2732,7 +10447,7 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2740,17 +10455,17 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DuplicatePair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DuplicatePair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 99:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id1", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNgtd
@@ -2760,21 +10475,71 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthNgtd
 			}
-			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Id1 = append(m.Id1[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id1 == nil {
+				m.Id1 = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id2", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id2 = append(m.Id2[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id2 == nil {
+				m.Id2 = []byte{}
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distance", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Distance = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNgtd(dAtA[iNdEx:])
@@ -2784,6 +10549,9 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthNgtd
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2797,7 +10565,7 @@ func (m *RemoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *DuplicatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2812,7 +10580,7 @@ func (m *CreateIndexRequest) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2820,17 +10588,89 @@ func (m *CreateIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DuplicatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DuplicatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, &DuplicatePair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			m.Done = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Done |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.PoolSize = 0
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNgtd
@@ -2840,11 +10680,24 @@ func (m *CreateIndexRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolSize |= (uint32(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNgtd(dAtA[iNdEx:])
@@ -2854,6 +10707,9 @@ func (m *CreateIndexRequest) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthNgtd
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2867,7 +10723,7 @@ func (m *CreateIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDimensionResponse) Unmarshal(dAtA []byte) error {
+func (m *Collection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2882,7 +10738,7 @@ func (m *GetDimensionResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -2890,13 +10746,45 @@ func (m *GetDimensionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDimensionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Collection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDimensionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Collection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Dimension", wireType)
 			}
@@ -2910,67 +10798,48 @@ func (m *GetDimensionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Dimension |= (int32(b) & 0x7F) << shift
+				m.Dimension |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNgtd(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistanceType", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNgtd
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetObjectRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNgtd
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNgtd
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetObjectRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DistanceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectType", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNgtd
@@ -2980,23 +10849,44 @@ func (m *GetObjectRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNgtd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthNgtd
 			}
-			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
-			}
+			m.ObjectType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Normalize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNgtd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Normalize = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNgtd(dAtA[iNdEx:])
@@ -3006,6 +10896,9 @@ func (m *GetObjectRequest) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthNgtd
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3019,7 +10912,7 @@ func (m *GetObjectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetObjectResponse) Unmarshal(dAtA []byte) error {
+func (m *ListCollectionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3034,7 +10927,7 @@ func (m *GetObjectResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -3042,17 +10935,17 @@ func (m *GetObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCollectionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCollectionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collections", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNgtd
@@ -3062,102 +10955,25 @@ func (m *GetObjectResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNgtd
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType == 5 {
-				var v uint32
-				if (iNdEx + 4) > l {
-					return io.ErrUnexpectedEOF
-				}
-				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-				iNdEx += 4
-				v2 := float32(math.Float32frombits(v))
-				m.Vector = append(m.Vector, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowNgtd
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthNgtd
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen / 4
-				if elementCount != 0 && len(m.Vector) == 0 {
-					m.Vector = make([]float32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					if (iNdEx + 4) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-					iNdEx += 4
-					v2 := float32(math.Float32frombits(v))
-					m.Vector = append(m.Vector, v2)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vector", wireType)
-			}
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNgtd
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthNgtd
 			}
-			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Collections = append(m.Collections, &Collection{})
+			if err := m.Collections[len(m.Collections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3168,6 +10984,9 @@ func (m *GetObjectResponse) Unmarshal(dAtA []byte) error {
 			if skippy < 0 {
 				return ErrInvalidLengthNgtd
 			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNgtd
+			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3235,10 +11054,13 @@ func skipNgtd(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			iNdEx += length
 			if length < 0 {
 				return 0, ErrInvalidLengthNgtd
 			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthNgtd
+			}
 			return iNdEx, nil
 		case 3:
 			for {
@@ -3267,6 +11089,9 @@ func skipNgtd(dAtA []byte) (n int, err error) {
 					return 0, err
 				}
 				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthNgtd
+				}
 			}
 			return iNdEx, nil
 		case 4:
@@ -32,13 +32,65 @@ type Route struct {
 }
 
 var (
-	routes = []Route{
+	routes = append(append([]Route{
 		Route{
 			"Index",
 			http.MethodGet,
 			"/",
 			handler.Index,
 		},
+		Route{
+			"ListCollections",
+			http.MethodGet,
+			"/collections",
+			handler.ListCollections,
+		},
+		Route{
+			"CreateCollection",
+			http.MethodPost,
+			"/collections/{collection}",
+			handler.CreateCollection,
+		},
+		Route{
+			"DropCollection",
+			http.MethodDelete,
+			"/collections/{collection}",
+			handler.DropCollection,
+		},
+		Route{
+			"ListJobs",
+			http.MethodGet,
+			"/jobs",
+			handler.ListJobs,
+		},
+		Route{
+			"GetJob",
+			http.MethodGet,
+			"/jobs/{id}",
+			handler.GetJob,
+		},
+		Route{
+			"CancelJob",
+			http.MethodPost,
+			"/jobs/{id}/cancel",
+			handler.CancelJob,
+		},
+		Route{
+			"DeleteJob",
+			http.MethodDelete,
+			"/jobs/{id}",
+			handler.DeleteJob,
+		},
+		Route{
+			"Reload",
+			http.MethodPost,
+			"/reload",
+			handler.Reload,
+		},
+	}, collectionRoutes...), inCollection(collectionRoutes)...)
+
+	// collectionRoutes are served for the default index and under /collections/{collection} for named ones.
+	collectionRoutes = []Route{
 		Route{
 			"Search",
 			http.MethodPost,
@@ -51,12 +103,54 @@ var (
 			"/searchbyid",
 			handler.SearchByID,
 		},
+		Route{
+			"SearchRange",
+			http.MethodPost,
+			"/searchrange",
+			handler.SearchRange,
+		},
+		Route{
+			"SearchRangeByID",
+			http.MethodPost,
+			"/searchrangebyid",
+			handler.SearchRangeByID,
+		},
+		Route{
+			"SearchByExamples",
+			http.MethodPost,
+			"/searchbyexamples",
+			handler.SearchByExamples,
+		},
+		Route{
+			"SearchByExpression",
+			http.MethodPost,
+			"/searchbyexpression",
+			handler.SearchByExpression,
+		},
+		Route{
+			"MultiSearch",
+			http.MethodPost,
+			"/multisearch",
+			handler.MultiSearch,
+		},
 		Route{
 			"Insert",
 			http.MethodPost,
 			"/insert",
 			handler.Insert,
 		},
+		Route{
+			"Upsert",
+			http.MethodPost,
+			"/upsert",
+			handler.Upsert,
+		},
+		Route{
+			"Update",
+			http.MethodPost,
+			"/update",
+			handler.Update,
+		},
 		Route{
 			"MultiInsert",
 			http.MethodPost,
@@ -105,6 +199,42 @@ var (
 			"/getobjects",
 			handler.GetObjects,
 		},
+		Route{
+			"ListIDs",
+			http.MethodGet,
+			"/ids",
+			handler.ListIDs,
+		},
+		Route{
+			"Count",
+			http.MethodGet,
+			"/count",
+			handler.Count,
+		},
+		Route{
+			"GetStats",
+			http.MethodGet,
+			"/stats",
+			handler.GetStats,
+		},
+		Route{
+			"Health",
+			http.MethodGet,
+			"/health",
+			handler.Health,
+		},
+		Route{
+			"Snapshot",
+			http.MethodPost,
+			"/snapshot",
+			handler.Snapshot,
+		},
+		Route{
+			"StartDuplicates",
+			http.MethodPost,
+			"/duplicates",
+			handler.StartDuplicates,
+		},
 	}
 
 	profiles = []Route{
@@ -164,3 +294,16 @@ var (
 		},
 	}
 )
+
+func inCollection(rs []Route) []Route {
+	ret := make([]Route, len(rs))
+	for i, r := range rs {
+		ret[i] = Route{
+			"Collection" + r.Name,
+			r.Method,
+			"/collections/{collection}" + r.Pattern,
+			r.HandlerFunc,
+		}
+	}
+	return ret
+}
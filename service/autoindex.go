@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"time"
+)
+
+var (
+	// autoIndexConfig is applied to collections opened after SetAutoIndex.
+	autoIndexConfig AutoIndexConfig
+)
+
+// AutoIndexConfig is the policy of running CreateIndex in the background.
+type AutoIndexConfig struct {
+	// Pending runs CreateIndex once this many inserted objects are not indexed yet. 0 disables it.
+	Pending int
+	// Interval runs CreateIndex this long after the last run if some objects are not indexed yet. 0 disables it.
+	Interval time.Duration
+	// PoolSize is the number of CPU using CreateIndex.
+	PoolSize int
+}
+
+func (c AutoIndexConfig) enabled() bool {
+	return c.Pending > 0 || c.Interval > 0
+}
+
+// AutoIndexStatus reports the policy and the last run of the background CreateIndex.
+type AutoIndexStatus struct {
+	AutoIndexConfig
+	RunStatus
+}
+
+type autoIndexer struct {
+	cfg AutoIndexConfig
+	*background
+}
+
+// SetAutoIndex starts the background CreateIndex of the default index with cfg,
+// and makes collections opened afterwards do the same. It should be called before serving.
+func SetAutoIndex(cfg AutoIndexConfig) {
+	autoIndexConfig = cfg
+	Get().StartAutoIndex(cfg)
+}
+
+func StopAutoIndex() {
+	Get().StopAutoIndex()
+}
+
+// StartAutoIndex runs CreateIndex with cfg.PoolSize in the background whenever cfg.Pending objects are waiting
+// for the index, or cfg.Interval has passed since the last run with some objects waiting.
+// It replaces the running policy, and does nothing else if cfg enables neither trigger.
+func (s *Service) StartAutoIndex(cfg AutoIndexConfig) {
+	s.StopAutoIndex()
+	if !cfg.enabled() {
+		return
+	}
+	a := &autoIndexer{
+		cfg: cfg,
+		background: newBackground("auto index", cfg.Pending, cfg.Interval, s.stats.pending, func() error {
+			return s.CreateIndex(cfg.PoolSize)
+		}),
+	}
+	s.amu.Lock()
+	s.autoIndex = a
+	s.amu.Unlock()
+}
+
+// StopAutoIndex stops the background CreateIndex, waiting for the running one.
+func (s *Service) StopAutoIndex() {
+	s.amu.Lock()
+	a := s.autoIndex
+	s.autoIndex = nil
+	s.amu.Unlock()
+	if a != nil {
+		a.close()
+	}
+}
+
+// AutoIndexStatus returns the status of the background CreateIndex, or nil if it is disabled.
+func (s *Service) AutoIndexStatus() *AutoIndexStatus {
+	s.amu.Lock()
+	a := s.autoIndex
+	s.amu.Unlock()
+	if a == nil {
+		return nil
+	}
+	return &AutoIndexStatus{
+		AutoIndexConfig: a.cfg,
+		RunStatus:       a.runStatus(),
+	}
+}
+
+// notifyInserted wakes up the background CreateIndex if pending objects reach the threshold.
+func (s *Service) notifyInserted(pending int) {
+	s.amu.Lock()
+	a := s.autoIndex
+	s.amu.Unlock()
+	if a != nil {
+		a.notify(pending)
+	}
+}
@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yahoojapan/gongt"
+)
+
+// waitAutoIndex waits for the background CreateIndex to run runs times and index every object.
+func waitAutoIndex(t *testing.T, runs int) *Stats {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		st, err := GetStats()
+		if err != nil {
+			t.Fatalf("Unexpected error: waitAutoIndex(%v)", err)
+		}
+		if st.UnindexedObjects == 0 && st.AutoIndex != nil && st.AutoIndex.Runs >= runs {
+			return st
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("waitAutoIndex: %+v, %+v, wanted: %d runs and no unindexed objects", st, st.AutoIndex, runs)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestAutoIndex(t *testing.T) {
+	gongt.Get().SetObjectType(gongt.Float)
+
+	t.Run("TestPending", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		Get().StartAutoIndex(AutoIndexConfig{Pending: 2, PoolSize: 1})
+		defer Get().StopAutoIndex()
+
+		if err := Insert([]float64{1, 1, 0, 0, 0, 0}, []byte("g")); err != nil {
+			t.Fatalf("Unexpected error: TestPending(%v)", err)
+		}
+		time.Sleep(50 * time.Millisecond)
+		if st, _ := GetStats(); st.UnindexedObjects != 1 || st.AutoIndex.Runs != 0 {
+			t.Errorf("TestPending: %+v, %+v, wanted: 1 unindexed object below the threshold", st, st.AutoIndex)
+		}
+
+		if err := Insert([]float64{0, 1, 1, 0, 0, 0}, []byte("h")); err != nil {
+			t.Fatalf("Unexpected error: TestPending(%v)", err)
+		}
+		st := waitAutoIndex(t, 1)
+		if st.AutoIndex.LastError != nil || st.AutoIndex.LastRun.IsZero() {
+			t.Errorf("TestPending: %+v, wanted: a successful run", st.AutoIndex)
+		}
+		if res, err := Search([]float64{0, 1, 1, 0, 0, 0}, 1, 0); err != nil || len(res) != 1 || string(res[0].Id) != "h" {
+			t.Errorf("TestPending: %v, %v, wanted: h", res, err)
+		}
+	})
+
+	t.Run("TestInterval", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		Get().StartAutoIndex(AutoIndexConfig{Interval: 20 * time.Millisecond, PoolSize: 1})
+		defer Get().StopAutoIndex()
+
+		if err := Insert([]float64{1, 1, 0, 0, 0, 0}, []byte("g")); err != nil {
+			t.Fatalf("Unexpected error: TestInterval(%v)", err)
+		}
+		waitAutoIndex(t, 1)
+	})
+
+	t.Run("TestDisabled", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		Get().StartAutoIndex(AutoIndexConfig{PoolSize: 1})
+		defer Get().StopAutoIndex()
+
+		if st, _ := GetStats(); st.AutoIndex != nil {
+			t.Errorf("TestDisabled: %+v, wanted: nil", st.AutoIndex)
+		}
+	})
+}
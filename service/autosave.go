@@ -0,0 +1,150 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"time"
+
+	"github.com/kpango/glg"
+)
+
+var (
+	// autoSaveConfig is applied to collections opened after SetAutoSave.
+	autoSaveConfig AutoSaveConfig
+)
+
+// AutoSaveConfig is the policy of running SaveIndex in the background.
+type AutoSaveConfig struct {
+	// Unsaved runs SaveIndex once this many objects changed since the last save. 0 disables it.
+	Unsaved int
+	// Interval runs SaveIndex this long after the last run if some objects changed. 0 disables it.
+	Interval time.Duration
+}
+
+func (c AutoSaveConfig) enabled() bool {
+	return c.Unsaved > 0 || c.Interval > 0
+}
+
+// AutoSaveStatus reports the policy and the last run of the background SaveIndex.
+type AutoSaveStatus struct {
+	AutoSaveConfig
+	RunStatus
+}
+
+type autoSaver struct {
+	cfg AutoSaveConfig
+	*background
+}
+
+// SetAutoSave starts the background SaveIndex of the default index with cfg,
+// and makes collections opened afterwards do the same. It should be called before serving.
+func SetAutoSave(cfg AutoSaveConfig) {
+	autoSaveConfig = cfg
+	Get().StartAutoSave(cfg)
+}
+
+func StopAutoSave() {
+	Get().StopAutoSave()
+}
+
+// StartAutoSave runs SaveIndex in the background whenever cfg.Unsaved objects changed since the last save,
+// or cfg.Interval has passed since the last run with some objects changed.
+// It replaces the running policy, and does nothing else if cfg enables neither trigger.
+func (s *Service) StartAutoSave(cfg AutoSaveConfig) {
+	s.StopAutoSave()
+	if !cfg.enabled() {
+		return
+	}
+	a := &autoSaver{
+		cfg:        cfg,
+		background: newBackground("auto save", cfg.Unsaved, cfg.Interval, s.stats.unsavedObjects, s.SaveIndex),
+	}
+	s.amu.Lock()
+	s.autoSave = a
+	s.amu.Unlock()
+}
+
+// StopAutoSave stops the background SaveIndex, waiting for the running one.
+func (s *Service) StopAutoSave() {
+	s.amu.Lock()
+	a := s.autoSave
+	s.autoSave = nil
+	s.amu.Unlock()
+	if a != nil {
+		a.close()
+	}
+}
+
+// AutoSaveStatus returns the status of the background SaveIndex, or nil if it is disabled.
+func (s *Service) AutoSaveStatus() *AutoSaveStatus {
+	s.amu.Lock()
+	a := s.autoSave
+	s.amu.Unlock()
+	if a == nil {
+		return nil
+	}
+	return &AutoSaveStatus{
+		AutoSaveConfig: a.cfg,
+		RunStatus:      a.runStatus(),
+	}
+}
+
+// notifyChanged wakes up the background SaveIndex if unsaved objects reach the threshold.
+func (s *Service) notifyChanged(unsaved int) {
+	s.amu.Lock()
+	a := s.autoSave
+	s.amu.Unlock()
+	if a != nil {
+		a.notify(unsaved)
+	}
+}
+
+// Shutdown stops the background tasks of the default index and every collection,
+// and saves the ones with objects changed since their last save. A failure is logged and the others are still saved.
+func Shutdown() error {
+	// a reload in progress swaps in the index to be saved first
+	reload.mu.Lock()
+	defer reload.mu.Unlock()
+	err := Get().Shutdown()
+	if e := collections.Shutdown(); e != nil {
+		err = e
+	}
+	return err
+}
+
+// Shutdown stops the background CreateIndex and SaveIndex, and saves the changes left.
+func (s *Service) Shutdown() error {
+	s.StopAutoIndex()
+	s.StopAutoSave()
+	return s.SaveChanges()
+}
+
+// SaveChanges runs SaveIndex if some objects changed since the last save.
+func (s *Service) SaveChanges() error {
+	s, leave := s.enter()
+	defer leave()
+	if s.stats.unsavedObjects() == 0 {
+		return nil
+	}
+	start := time.Now()
+	if err := s.SaveIndex(); err != nil {
+		glg.Errorf("cannot save %s: %v", s.ngtPath(), err)
+		return err
+	}
+	glg.Infof("saved %s in %v", s.ngtPath(), time.Since(start))
+	return nil
+}
@@ -0,0 +1,136 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yahoojapan/gongt"
+)
+
+// waitAutoSave waits for the background SaveIndex to run runs times and save every change.
+func waitAutoSave(t *testing.T, runs int) *Stats {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		st, err := GetStats()
+		if err != nil {
+			t.Fatalf("Unexpected error: waitAutoSave(%v)", err)
+		}
+		if st.UnsavedObjects == 0 && st.AutoSave != nil && st.AutoSave.Runs >= runs {
+			return st
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("waitAutoSave: %+v, %+v, wanted: %d runs and no unsaved objects", st, st.AutoSave, runs)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestAutoSave(t *testing.T) {
+	gongt.Get().SetObjectType(gongt.Float)
+
+	t.Run("TestUnsaved", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		Get().StartAutoSave(AutoSaveConfig{Unsaved: 2})
+		defer Get().StopAutoSave()
+
+		if err := Insert([]float64{1, 1, 0, 0, 0, 0}, []byte("g")); err != nil {
+			t.Fatalf("Unexpected error: TestUnsaved(%v)", err)
+		}
+		time.Sleep(50 * time.Millisecond)
+		if st, _ := GetStats(); st.UnsavedObjects != 1 || st.AutoSave.Runs != 0 {
+			t.Errorf("TestUnsaved: %+v, %+v, wanted: 1 unsaved object below the threshold", st, st.AutoSave)
+		}
+
+		if err := Remove([]byte("a")); err != nil {
+			t.Fatalf("Unexpected error: TestUnsaved(%v)", err)
+		}
+		st := waitAutoSave(t, 1)
+		if st.AutoSave.LastError != nil || st.LastSaveIndex.IsZero() {
+			t.Errorf("TestUnsaved: %+v, wanted: a successful save", st.AutoSave)
+		}
+	})
+
+	t.Run("TestInterval", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		Get().StartAutoSave(AutoSaveConfig{Interval: 20 * time.Millisecond})
+		defer Get().StopAutoSave()
+
+		if err := Insert([]float64{1, 1, 0, 0, 0, 0}, []byte("g")); err != nil {
+			t.Fatalf("Unexpected error: TestInterval(%v)", err)
+		}
+		waitAutoSave(t, 1)
+	})
+
+	t.Run("TestIndexed", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		if err := Insert([]float64{1, 1, 0, 0, 0, 0}, []byte("g")); err != nil {
+			t.Fatalf("Unexpected error: TestIndexed(%v)", err)
+		}
+		if err := SaveIndex(); err != nil {
+			t.Fatalf("Unexpected error: TestIndexed(%v)", err)
+		}
+		if err := CreateIndex(1); err != nil {
+			t.Fatalf("Unexpected error: TestIndexed(%v)", err)
+		}
+		if st, _ := GetStats(); st.UnsavedObjects != 1 {
+			t.Errorf("TestIndexed: %d, wanted: the object indexed after the save is unsaved", st.UnsavedObjects)
+		}
+	})
+
+	t.Run("TestShutdown", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		Get().StartAutoSave(AutoSaveConfig{Interval: time.Hour})
+		if err := Insert([]float64{1, 1, 0, 0, 0, 0}, []byte("g")); err != nil {
+			t.Fatalf("Unexpected error: TestShutdown(%v)", err)
+		}
+		before := time.Now()
+		if err := Get().Shutdown(); err != nil {
+			t.Fatalf("Unexpected error: TestShutdown(%v)", err)
+		}
+		st, _ := GetStats()
+		if st.UnsavedObjects != 0 || st.LastSaveIndex.Before(before) || st.AutoSave != nil {
+			t.Errorf("TestShutdown: %+v, wanted: saved and the background SaveIndex stopped", st)
+		}
+	})
+}
+
+func TestHealth(t *testing.T) {
+	gongt.Get().SetObjectType(gongt.Float)
+	defer SetupWithTeardown(t)()
+	if err := SaveIndex(); err != nil {
+		t.Fatalf("Unexpected error: TestHealth(%v)", err)
+	}
+
+	if h := GetHealth(); !h.Healthy() || h.UnsavedObjects != 0 || h.LastSaveIndex.IsZero() {
+		t.Errorf("TestHealth: %+v, wanted: healthy", h)
+	}
+
+	Get().stats.saveFailed(errors.New("no space left on device"))
+	if h := GetHealth(); h.Healthy() || len(h.Errors) != 1 {
+		t.Errorf("TestHealth: %+v, wanted: the failed save", h)
+	}
+
+	if err := SaveIndex(); err != nil {
+		t.Fatalf("Unexpected error: TestHealth(%v)", err)
+	}
+	if h := GetHealth(); !h.Healthy() {
+		t.Errorf("TestHealth: %+v, wanted: healthy after a successful save", h)
+	}
+}
@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kpango/glg"
+)
+
+// RunStatus is the record of a background task.
+type RunStatus struct {
+	Runs         int
+	LastRun      time.Time
+	LastDuration time.Duration
+	LastError    error
+}
+
+// background runs task in a goroutine once threshold changes are waiting,
+// or interval has passed since the last run with some changes waiting.
+// Either trigger is disabled if it is 0.
+type background struct {
+	name      string
+	threshold int
+	interval  time.Duration
+	// waiting returns the number of changes task handles.
+	waiting func() int
+	task    func() error
+
+	trigger chan struct{}
+	stop    chan struct{}
+	done    chan struct{}
+
+	mu     sync.Mutex
+	status RunStatus
+}
+
+func newBackground(name string, threshold int, interval time.Duration, waiting func() int, task func() error) *background {
+	b := &background{
+		name:      name,
+		threshold: threshold,
+		interval:  interval,
+		waiting:   waiting,
+		task:      task,
+		trigger:   make(chan struct{}, 1),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+// notify wakes up the task if n changes reach the threshold.
+func (b *background) notify(n int) {
+	if b == nil || b.threshold <= 0 || n < b.threshold {
+		return
+	}
+	select {
+	case b.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// close stops the goroutine, waiting for the running task.
+func (b *background) close() {
+	close(b.stop)
+	<-b.done
+}
+
+func (b *background) runStatus() RunStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.status
+}
+
+func (b *background) run() {
+	defer close(b.done)
+	var timer *time.Timer
+	var tick <-chan time.Time
+	if b.interval > 0 {
+		timer = time.NewTimer(b.interval)
+		defer timer.Stop()
+		tick = timer.C
+	}
+	for {
+		select {
+		case <-b.stop:
+			return
+		case <-b.trigger:
+			if timer != nil && !timer.Stop() {
+				<-timer.C
+			}
+		case <-tick:
+		}
+		if b.waiting() > 0 {
+			start := time.Now()
+			err := b.task()
+			if err != nil {
+				glg.Errorf("%s failed: %v", b.name, err)
+			}
+			b.mu.Lock()
+			b.status.Runs++
+			b.status.LastRun = start
+			b.status.LastDuration = time.Since(start)
+			b.status.LastError = err
+			b.mu.Unlock()
+		}
+		if timer != nil {
+			timer.Reset(b.interval)
+		}
+	}
+}
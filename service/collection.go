@@ -0,0 +1,362 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/yahoojapan/gongt"
+	"github.com/yahoojapan/ngtd/kvs"
+)
+
+const (
+	collectionConfigFile = "collection.json"
+	collectionIndexDir   = "index"
+	collectionKVSPath    = "kvs"
+	collectionJournal    = "journal"
+)
+
+var (
+	ErrCollectionsDisabled     = errors.New("collections are disabled")
+	ErrCollectionNotFound      = errors.New("collection not found")
+	ErrCollectionAlreadyExists = errors.New("collection already exists")
+	ErrInvalidCollectionName   = errors.New("invalid collection name")
+	ErrInvalidCollectionDim    = errors.New("collection dimension must be greater than 0")
+	ErrUnsupportedDistanceType = errors.New("unsupported distance type")
+	ErrUnsupportedObjectType   = errors.New("unsupported object type")
+	ErrNormalizeUnsupported    = errors.New("normalize is not supported for object type uint8")
+
+	collectionNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+	collections = &Collections{}
+)
+
+// CollectionConfig describes a named collection. It is stored as JSON in the collection directory.
+type CollectionConfig struct {
+	Name         string `json:"name"`
+	Dimension    int    `json:"dimension"`
+	DistanceType string `json:"distance_type"`
+	ObjectType   string `json:"object_type"`
+	// Normalize makes the collection L2-normalize vectors on insert and on query.
+	Normalize bool `json:"normalize"`
+}
+
+// Collections holds named collections, each one with its own index and KVS under root.
+type Collections struct {
+	mu    sync.RWMutex
+	root  string
+	newDB func(string) (kvs.KVS, error)
+	cols  map[string]*collection
+}
+
+type collection struct {
+	config CollectionConfig
+	srv    *Service
+}
+
+// OpenCollections enables named collections stored under root and reopens the ones created before.
+// newDB opens the KVS of a collection at the given path.
+func OpenCollections(root string, newDB func(string) (kvs.KVS, error)) error {
+	return collections.Open(root, newDB)
+}
+
+// Open enables named collections stored under root and reopens the ones created before.
+func (c *Collections) Open(root string, newDB func(string) (kvs.KVS, error)) error {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return err
+	}
+	dirs, err := ioutil.ReadDir(root)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.root = root
+	c.newDB = newDB
+	c.cols = make(map[string]*collection, len(dirs))
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		b, err := ioutil.ReadFile(filepath.Join(root, d.Name(), collectionConfigFile))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		var cfg CollectionConfig
+		if err := json.Unmarshal(b, &cfg); err != nil {
+			return fmt.Errorf("collection %s: %v", d.Name(), err)
+		}
+		col, err := c.open(cfg)
+		if err != nil {
+			return fmt.Errorf("collection %s: %v", d.Name(), err)
+		}
+		c.cols[cfg.Name] = col
+	}
+	return nil
+}
+
+func (c *Collections) open(cfg CollectionConfig) (*collection, error) {
+	dt, err := toDistanceType(cfg.DistanceType)
+	if err != nil {
+		return nil, err
+	}
+	ot, err := toObjectType(cfg.ObjectType)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Join(c.root, cfg.Name)
+	ngt := gongt.New(filepath.Join(dir, collectionIndexDir)).
+		SetDimension(cfg.Dimension).
+		SetDistanceType(dt).
+		SetObjectType(ot).
+		Open()
+	if errs := ngt.GetErrors(); len(errs) > 0 {
+		ngt.Close()
+		return nil, fmt.Errorf("%v", errs)
+	}
+
+	db, err := c.newDB(filepath.Join(dir, collectionKVSPath))
+	if err != nil {
+		ngt.Close()
+		return nil, err
+	}
+
+	srv := NewServiceWithNGT(ngt, db)
+	srv.distanceType = cfg.DistanceType
+	srv.normalize = cfg.Normalize
+	if err := srv.OpenWAL(WALPath(filepath.Join(dir, collectionIndexDir))); err != nil {
+		srv.Close()
+		return nil, err
+	}
+	if err := srv.OpenJournal(filepath.Join(dir, collectionJournal)); err != nil {
+		srv.Close()
+		return nil, err
+	}
+	srv.StartAutoIndex(autoIndexConfig)
+	srv.StartAutoSave(autoSaveConfig)
+	return &collection{
+		config: cfg,
+		srv:    srv,
+	}, nil
+}
+
+func GetCollection(name string) (*Service, error) {
+	return collections.Get(name)
+}
+
+// Get returns Service of the named collection. Empty name means the default index.
+func (c *Collections) Get(name string) (*Service, error) {
+	if name == "" {
+		return Get(), nil
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.cols == nil {
+		return nil, ErrCollectionsDisabled
+	}
+	col, ok := c.cols[name]
+	if !ok {
+		return nil, ErrCollectionNotFound
+	}
+	return col.srv, nil
+}
+
+func CreateCollection(cfg CollectionConfig) error {
+	return collections.Create(cfg)
+}
+
+// Create makes a new collection with empty index and KVS.
+func (c *Collections) Create(cfg CollectionConfig) error {
+	if !collectionNamePattern.MatchString(cfg.Name) {
+		return ErrInvalidCollectionName
+	}
+	if cfg.Dimension <= 0 {
+		return ErrInvalidCollectionDim
+	}
+	if cfg.DistanceType == "" {
+		cfg.DistanceType = "l2"
+	}
+	if cfg.ObjectType == "" {
+		cfg.ObjectType = "float"
+	}
+	if cfg.Normalize && cfg.ObjectType == "uint8" {
+		// normalized elements are less than 1 and all round to 0
+		return ErrNormalizeUnsupported
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cols == nil {
+		return ErrCollectionsDisabled
+	}
+	if _, ok := c.cols[cfg.Name]; ok {
+		return ErrCollectionAlreadyExists
+	}
+
+	dir := filepath.Join(c.root, cfg.Name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	col, err := c.open(cfg)
+	if err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+	b, err := json.Marshal(cfg)
+	if err == nil {
+		err = ioutil.WriteFile(filepath.Join(dir, collectionConfigFile), b, 0644)
+	}
+	if err != nil {
+		col.srv.Close()
+		os.RemoveAll(dir)
+		return err
+	}
+	c.cols[cfg.Name] = col
+	return nil
+}
+
+func DropCollection(name string) error {
+	return collections.Drop(name)
+}
+
+// Drop closes the collection and removes its index and KVS.
+func (c *Collections) Drop(name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cols == nil {
+		return ErrCollectionsDisabled
+	}
+	col, ok := c.cols[name]
+	if !ok {
+		return ErrCollectionNotFound
+	}
+	delete(c.cols, name)
+	if err := col.srv.Close(); err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(c.root, name))
+}
+
+func ListCollections() ([]CollectionConfig, error) {
+	return collections.List()
+}
+
+// List returns configs of all collections sorted by name.
+func (c *Collections) List() ([]CollectionConfig, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.cols == nil {
+		return nil, ErrCollectionsDisabled
+	}
+	ret := make([]CollectionConfig, 0, len(c.cols))
+	for _, col := range c.cols {
+		ret = append(ret, col.config)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret, nil
+}
+
+// Shutdown shuts down every collection, leaving them open.
+func (c *Collections) Shutdown() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var err error
+	for _, col := range c.cols {
+		if e := col.srv.Shutdown(); e != nil {
+			err = e
+		}
+	}
+	return err
+}
+
+func CloseCollections() error {
+	return collections.Close()
+}
+
+// Close closes every collection.
+func (c *Collections) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var err error
+	for name, col := range c.cols {
+		if e := col.srv.Close(); e != nil {
+			err = e
+		}
+		delete(c.cols, name)
+	}
+	return err
+}
+
+func toDistanceType(s string) (gongt.DistanceType, error) {
+	switch s {
+	case "l1":
+		return gongt.L1, nil
+	case "l2":
+		return gongt.L2, nil
+	case "angle":
+		return gongt.Angle, nil
+	case "hamming":
+		return gongt.Hamming, nil
+	case "cosine":
+		return gongt.Cosine, nil
+	}
+	return gongt.DistanceNone, ErrUnsupportedDistanceType
+}
+
+func toObjectType(s string) (gongt.ObjectType, error) {
+	switch s {
+	case "float":
+		return gongt.Float, nil
+	case "uint8":
+		return gongt.Uint8, nil
+	}
+	return gongt.ObjectNone, ErrUnsupportedObjectType
+}
+
+// IndexObjectType returns the object type of the NGT index at path, "float" or "uint8", read from its property file.
+// It returns "float" for an index which does not exist yet, since gongt creates one of that type.
+func IndexObjectType(path string) (string, error) {
+	b, err := ioutil.ReadFile(filepath.Join(path, "prf"))
+	if os.IsNotExist(err) {
+		return "float", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		f := strings.Fields(line)
+		if len(f) == 2 && f[0] == "ObjectType" && f[1] == "Integer-1" {
+			return "uint8", nil
+		}
+	}
+	return "float", nil
+}
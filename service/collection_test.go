@@ -0,0 +1,171 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/yahoojapan/gongt"
+	"github.com/yahoojapan/ngtd/kvs"
+	"github.com/yahoojapan/ngtd/ngtdtest"
+)
+
+func newMapDB(string) (kvs.KVS, error) {
+	return ngtdtest.NewMap(), nil
+}
+
+func TestCollections(t *testing.T) {
+	root, err := ioutil.TempDir("", "ngtd-collections")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestCollections(%v)", err)
+	}
+	defer os.RemoveAll(root)
+
+	c := &Collections{}
+	if _, err := c.Get("foo"); err != ErrCollectionsDisabled {
+		t.Errorf("TestCollections: %v, wanted: %v", err, ErrCollectionsDisabled)
+	}
+	if err := c.Open(root, newMapDB); err != nil {
+		t.Fatalf("Unexpected error: TestCollections(%v)", err)
+	}
+	defer c.Close()
+
+	t.Run("TestCreate", func(t *testing.T) {
+		tests := []struct {
+			cfg  CollectionConfig
+			want error
+		}{
+			{CollectionConfig{Name: "foo", Dimension: 2}, nil},
+			{CollectionConfig{Name: "bar", Dimension: 3, DistanceType: "cosine"}, nil},
+			{CollectionConfig{Name: "foo", Dimension: 2}, ErrCollectionAlreadyExists},
+			{CollectionConfig{Name: "../baz", Dimension: 2}, ErrInvalidCollectionName},
+			{CollectionConfig{Name: "baz", Dimension: 0}, ErrInvalidCollectionDim},
+			{CollectionConfig{Name: "baz", Dimension: 2, DistanceType: "foo"}, ErrUnsupportedDistanceType},
+			{CollectionConfig{Name: "baz", Dimension: 2, ObjectType: "uint8", Normalize: true}, ErrNormalizeUnsupported},
+		}
+		for _, tt := range tests {
+			if err := c.Create(tt.cfg); err != tt.want {
+				t.Errorf("TestCreate(%v): %v, wanted: %v", tt.cfg, err, tt.want)
+			}
+		}
+	})
+
+	t.Run("TestIsolation", func(t *testing.T) {
+		foo, err := c.Get("foo")
+		if err != nil {
+			t.Fatalf("Unexpected error: TestIsolation(%v)", err)
+		}
+		bar, err := c.Get("bar")
+		if err != nil {
+			t.Fatalf("Unexpected error: TestIsolation(%v)", err)
+		}
+		if foo.GetDim() != 2 || bar.GetDim() != 3 {
+			t.Errorf("TestIsolation: dimensions %v, %v, wanted: 2, 3", foo.GetDim(), bar.GetDim())
+		}
+
+		if err := foo.Insert([]float64{1, 0}, []byte("a")); err != nil {
+			t.Errorf("Unexpected error: TestIsolation(%v)", err)
+		}
+		if err := foo.CreateIndex(1); err != nil {
+			t.Errorf("Unexpected error: TestIsolation(%v)", err)
+		}
+		res, err := foo.Search([]float64{1, 0}, 1, gongt.DefaultEpsilon)
+		if err != nil {
+			t.Errorf("Unexpected error: TestIsolation(%v)", err)
+		}
+		if len(res) != 1 || !reflect.DeepEqual(res[0].Id, []byte("a")) {
+			t.Errorf("TestIsolation: %v, wanted: a", res)
+		}
+		if _, err := bar.GetObject([]byte("a")); err == nil {
+			t.Error("TestIsolation: object inserted into foo is visible in bar")
+		}
+	})
+
+	t.Run("TestList", func(t *testing.T) {
+		cfgs, err := c.List()
+		if err != nil {
+			t.Errorf("Unexpected error: TestList(%v)", err)
+		}
+		want := []CollectionConfig{
+			{Name: "bar", Dimension: 3, DistanceType: "cosine", ObjectType: "float"},
+			{Name: "foo", Dimension: 2, DistanceType: "l2", ObjectType: "float"},
+		}
+		if !reflect.DeepEqual(cfgs, want) {
+			t.Errorf("TestList: %v, wanted: %v", cfgs, want)
+		}
+	})
+
+	t.Run("TestReopen", func(t *testing.T) {
+		if err := c.Close(); err != nil {
+			t.Errorf("Unexpected error: TestReopen(%v)", err)
+		}
+		if err := c.Open(root, newMapDB); err != nil {
+			t.Fatalf("Unexpected error: TestReopen(%v)", err)
+		}
+		cfgs, err := c.List()
+		if err != nil || len(cfgs) != 2 {
+			t.Errorf("TestReopen: %v, %v, wanted: 2 collections", cfgs, err)
+		}
+	})
+
+	t.Run("TestDrop", func(t *testing.T) {
+		if err := c.Drop("foo"); err != nil {
+			t.Errorf("Unexpected error: TestDrop(%v)", err)
+		}
+		if err := c.Drop("foo"); err != ErrCollectionNotFound {
+			t.Errorf("TestDrop: %v, wanted: %v", err, ErrCollectionNotFound)
+		}
+		if _, err := c.Get("foo"); err != ErrCollectionNotFound {
+			t.Errorf("TestDrop: %v, wanted: %v", err, ErrCollectionNotFound)
+		}
+		if _, err := os.Stat(root + "/foo"); !os.IsNotExist(err) {
+			t.Errorf("TestDrop: collection directory still exists(%v)", err)
+		}
+	})
+}
+
+func TestIndexObjectType(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ngtd-index")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestIndexObjectType(%v)", err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		prf  string
+		want string
+	}{
+		{"", "float"},
+		{"Dimension\t6\nObjectType\tFloat-4\n", "float"},
+		{"Dimension\t6\nObjectType\tInteger-1\n", "uint8"},
+	}
+	for _, tt := range tests {
+		if tt.prf != "" {
+			if err := ioutil.WriteFile(filepath.Join(dir, "prf"), []byte(tt.prf), 0644); err != nil {
+				t.Fatalf("Unexpected error: TestIndexObjectType(%v)", err)
+			}
+		}
+		got, err := IndexObjectType(dir)
+		if err != nil || got != tt.want {
+			t.Errorf("TestIndexObjectType(%q): %v, %v, wanted: %v", tt.prf, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"math"
+	"math/bits"
+)
+
+type distanceFunc func(a []float64, b []float32) float32
+
+// toDistanceFunc returns the exact distance of metric, which is one of the distance types of collections.
+func toDistanceFunc(metric string) (distanceFunc, error) {
+	switch metric {
+	case "l1":
+		return l1, nil
+	case "l2":
+		return l2, nil
+	case "angle":
+		return angle, nil
+	case "cosine":
+		return cosine, nil
+	case "hamming":
+		return hamming, nil
+	}
+	return nil, ErrUnsupportedDistanceType
+}
+
+func SetDistanceType(distanceType string) error {
+	return Get().SetDistanceType(distanceType)
+}
+
+// SetDistanceType sets the distance type of the index, one of the names accepted by collections.
+// An index is created with it if the index is opened after, and an existing index has to be built with the same one.
+func (s *Service) SetDistanceType(distanceType string) error {
+	dt, err := toDistanceType(distanceType)
+	if err != nil {
+		return err
+	}
+	s.imu.Lock()
+	s.ngt.SetDistanceType(dt)
+	s.imu.Unlock()
+	s.distanceType = distanceType
+	return nil
+}
+
+func l1(a []float64, b []float32) float32 {
+	var d float64
+	for i := range a {
+		d += math.Abs(a[i] - float64(b[i]))
+	}
+	return float32(d)
+}
+
+func l2(a []float64, b []float32) float32 {
+	var d float64
+	for i := range a {
+		x := a[i] - float64(b[i])
+		d += x * x
+	}
+	return float32(math.Sqrt(d))
+}
+
+// cos returns cosine similarity of a and b. It is 0 if either of them is the zero vector.
+func cos(a []float64, b []float32) float64 {
+	var dot, na, nb float64
+	for i := range a {
+		dot += a[i] * float64(b[i])
+		na += a[i] * a[i]
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return math.Max(-1, math.Min(1, dot/math.Sqrt(na*nb)))
+}
+
+func angle(a []float64, b []float32) float32 {
+	return float32(math.Acos(cos(a, b)))
+}
+
+func cosine(a []float64, b []float32) float32 {
+	return float32(1 - cos(a, b))
+}
+
+// hamming counts the different bits of the elements as bytes, which is the hamming distance of uint8 objects in NGT.
+func hamming(a []float64, b []float32) float32 {
+	var d int
+	for i := range a {
+		d += bits.OnesCount8(uint8(a[i]) ^ uint8(b[i]))
+	}
+	return float32(d)
+}
@@ -0,0 +1,130 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"sort"
+)
+
+// DuplicatePair is a pair of objects within the threshold. ID1 is less than ID2 in byte order.
+type DuplicatePair struct {
+	ID1      []byte
+	ID2      []byte
+	Distance float32
+}
+
+// DuplicateCluster is a group of objects connected by duplicate pairs.
+// MaxDistance is the largest distance of the pairs in the group.
+type DuplicateCluster struct {
+	IDs         [][]byte
+	MaxDistance float32
+}
+
+func FindDuplicates(ctx context.Context, threshold, epsilon float32, progress func(done, total int), pair func(DuplicatePair) error) error {
+	return Get().FindDuplicates(ctx, threshold, epsilon, progress, pair)
+}
+
+// FindDuplicates walks every stored object and runs a range search of threshold for each.
+// pair is called for each pair of objects found, and progress after each object is searched.
+// They are never called concurrently. It stops with the error of ctx if ctx is canceled.
+func (s *Service) FindDuplicates(ctx context.Context, threshold, epsilon float32, progress func(done, total int), pair func(DuplicatePair) error) error {
+	s, leave := s.enter()
+	defer leave()
+	if threshold <= 0 {
+		return ErrInvalidRadius
+	}
+	total, err := s.CountContext(ctx)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	done := 0
+	return s.walk(ctx, func(id []byte) ([]SearchResult, error) {
+		return s.SearchRangeByIDContext(ctx, id, threshold, epsilon, WithExcludeSelf(true))
+	}, func(id []byte, res []SearchResult) error {
+		for _, r := range res {
+			if bytes.Compare(id, r.Id) >= 0 {
+				continue
+			}
+			if err := pair(DuplicatePair{ID1: id, ID2: r.Id, Distance: r.Distance}); err != nil {
+				return err
+			}
+		}
+		done++
+		if progress != nil {
+			progress(done, max(done, total))
+		}
+		return nil
+	})
+}
+
+// Clusters groups pairs into connected components. Clusters and their IDs are in byte order.
+func Clusters(pairs []DuplicatePair) []DuplicateCluster {
+	parent := make(map[string]string)
+	var find func(string) string
+	find = func(x string) string {
+		p, ok := parent[x]
+		if !ok || p == x {
+			parent[x] = x
+			return x
+		}
+		root := find(p)
+		parent[x] = root
+		return root
+	}
+	for _, p := range pairs {
+		a, b := find(string(p.ID1)), find(string(p.ID2))
+		if a < b {
+			parent[b] = a
+		} else if b < a {
+			parent[a] = b
+		}
+	}
+
+	groups := make(map[string]*DuplicateCluster)
+	for id := range parent {
+		root := find(id)
+		c, ok := groups[root]
+		if !ok {
+			c = &DuplicateCluster{}
+			groups[root] = c
+		}
+		c.IDs = append(c.IDs, []byte(id))
+	}
+	for _, p := range pairs {
+		c := groups[find(string(p.ID1))]
+		if p.Distance > c.MaxDistance {
+			c.MaxDistance = p.Distance
+		}
+	}
+
+	ret := make([]DuplicateCluster, 0, len(groups))
+	for _, c := range groups {
+		sort.Slice(c.IDs, func(i, j int) bool {
+			return bytes.Compare(c.IDs[i], c.IDs[j]) < 0
+		})
+		ret = append(ret, *c)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return bytes.Compare(ret[i].IDs[0], ret[j].IDs[0]) < 0
+	})
+	return ret
+}
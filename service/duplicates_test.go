@@ -0,0 +1,146 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/yahoojapan/gongt"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	gongt.Get().SetObjectType(gongt.Float)
+	defer SetupWithMeta(t)()
+
+	t.Run("TestPairs", func(t *testing.T) {
+		var pairs []DuplicatePair
+		var done, total int
+		err := FindDuplicates(context.Background(), 0.015, gongt.DefaultEpsilon, func(d, n int) {
+			done, total = d, n
+		}, func(p DuplicatePair) error {
+			pairs = append(pairs, p)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: TestPairs(%v)", err)
+		}
+		if done != 16 || total != 16 {
+			t.Errorf("TestPairs: progress %v/%v, wanted: 16/16", done, total)
+		}
+		want := map[string]bool{"a g0": true}
+		for i := 0; i < 9; i++ {
+			want[fmt.Sprintf("g%d g%d", i, i+1)] = true
+		}
+		for _, p := range pairs {
+			if !want[fmt.Sprintf("%s %s", p.ID1, p.ID2)] {
+				t.Errorf("TestPairs: unexpected pair %s %s %v", p.ID1, p.ID2, p.Distance)
+			}
+			delete(want, fmt.Sprintf("%s %s", p.ID1, p.ID2))
+		}
+		if len(want) > 0 {
+			t.Errorf("TestPairs: %v are not found", want)
+		}
+
+		clusters := Clusters(pairs)
+		if len(clusters) != 1 || len(clusters[0].IDs) != 11 || string(clusters[0].IDs[0]) != "a" {
+			t.Errorf("TestPairs: clusters %v, wanted: one of a and g0..g9", clusters)
+		}
+	})
+
+	t.Run("TestCancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := FindDuplicates(ctx, 0.015, gongt.DefaultEpsilon, nil, func(p DuplicatePair) error {
+			return nil
+		})
+		if err != context.Canceled {
+			t.Errorf("TestCancel: %v, wanted: %v", err, context.Canceled)
+		}
+	})
+
+	t.Run("TestJob", func(t *testing.T) {
+		if _, err := StartDuplicatesJob(0, gongt.DefaultEpsilon); err != ErrInvalidRadius {
+			t.Errorf("TestJob: %v, wanted: %v", err, ErrInvalidRadius)
+		}
+		j, err := StartDuplicatesJob(0.015, gongt.DefaultEpsilon)
+		if err != nil {
+			t.Fatalf("Unexpected error: TestJob(%v)", err)
+		}
+		defer DeleteJob(j.ID)
+		deadline := time.Now().Add(5 * time.Second)
+		for j.Status().State == JobRunning && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		st := j.Status()
+		if st.State != JobDone || st.Done != 16 || len(st.Pairs) != 10 {
+			t.Errorf("TestJob: %v %v/%v %v pairs, wanted: %v 16/16 10 pairs", st.State, st.Done, st.Total, len(st.Pairs), JobDone)
+		}
+		if got, err := GetJob(j.ID); err != nil || got != j {
+			t.Errorf("TestJob: GetJob(%v) = %v, %v", j.ID, got, err)
+		}
+	})
+
+	t.Run("TestCancelJob", func(t *testing.T) {
+		// cancel every job as soon as it is listed, even before StartDuplicatesJob returns
+		stop := make(chan struct{})
+		stopped := make(chan struct{})
+		go func() {
+			defer close(stopped)
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				for _, j := range ListJobs() {
+					CancelJob(j.ID)
+				}
+				runtime.Gosched()
+			}
+		}()
+		started := make([]*Job, 0, 100)
+		for i := 0; i < cap(started); i++ {
+			j, err := StartDuplicatesJob(0.015, gongt.DefaultEpsilon)
+			if err != nil {
+				t.Fatalf("Unexpected error: TestCancelJob(%v)", err)
+			}
+			started = append(started, j)
+			runtime.Gosched()
+		}
+		close(stop)
+		<-stopped
+
+		deadline := time.Now().Add(5 * time.Second)
+		for _, j := range started {
+			if err := CancelJob(j.ID); err != nil {
+				t.Errorf("Unexpected error: TestCancelJob(%v)", err)
+			}
+			for j.Status().State == JobRunning && time.Now().Before(deadline) {
+				time.Sleep(10 * time.Millisecond)
+			}
+			// the job may finish before it is canceled
+			if st := j.Status(); st.State != JobCanceled && st.State != JobDone {
+				t.Errorf("TestCancelJob(%v): %v, wanted: %v", j.ID, st.State, JobCanceled)
+			}
+			DeleteJob(j.ID)
+		}
+	})
+}
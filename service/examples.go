@@ -0,0 +1,113 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrNoPositiveExample = errors.New("no positive example")
+	ErrInvalidWeight     = errors.New("weight of example must not be negative")
+)
+
+// Example is a stored object which a query of SearchByExamples is made of. Weight 0 means 1.
+type Example struct {
+	ID     []byte
+	Weight float32
+}
+
+func SearchByExamples(positive, negative []Example, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return Get().SearchByExamples(positive, negative, size, epsilon, opts...)
+}
+
+// SearchByExamples searches the objects like the positive examples and unlike the negative ones.
+// The query is the weighted centroid of the positive examples minus the negative examples weighted
+// relative to the positive ones. The examples themselves are excluded from the results.
+func (s *Service) SearchByExamples(positive, negative []Example, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return s.SearchByExamplesContext(context.Background(), positive, negative, size, epsilon, opts...)
+}
+
+func SearchByExamplesContext(ctx context.Context, positive, negative []Example, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return Get().SearchByExamplesContext(ctx, positive, negative, size, epsilon, opts...)
+}
+
+// SearchByExamplesContext is SearchByExamples which stops with the error of ctx once ctx is done.
+func (s *Service) SearchByExamplesContext(ctx context.Context, positive, negative []Example, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	s, leave := s.enter()
+	defer leave()
+	if len(positive) == 0 {
+		return nil, ErrNoPositiveExample
+	}
+	query := make([]float64, s.ngtDim())
+	var total float64
+	for _, e := range positive {
+		w, err := e.weight()
+		if err != nil {
+			return nil, err
+		}
+		total += w
+	}
+	if err := s.addExamples(ctx, query, positive, 1/total); err != nil {
+		return nil, err
+	}
+	if err := s.addExamples(ctx, query, negative, -1/total); err != nil {
+		return nil, err
+	}
+	query, err := s.prepare(query)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := newSearchConfig(opts)
+	for _, e := range positive {
+		cfg.excludeIDs(e.ID)
+	}
+	for _, e := range negative {
+		cfg.excludeIDs(e.ID)
+	}
+	return s.search(ctx, query, size, epsilon, cfg)
+}
+
+// addExamples adds the vectors of examples multiplied by their weights and scale to query.
+func (s *Service) addExamples(ctx context.Context, query []float64, examples []Example, scale float64) error {
+	for _, e := range examples {
+		w, err := e.weight()
+		if err != nil {
+			return err
+		}
+		v, err := s.getVector(ctx, e.ID)
+		if err != nil {
+			return err
+		}
+		for i := range query {
+			query[i] += v[i] * w * scale
+		}
+	}
+	return nil
+}
+
+func (e Example) weight() (float64, error) {
+	switch {
+	case e.Weight < 0:
+		return 0, ErrInvalidWeight
+	case e.Weight == 0:
+		return 1, nil
+	}
+	return float64(e.Weight), nil
+}
@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrEmptyExpression = errors.New("expression has no term")
+	ErrInvalidTerm     = errors.New("term must have either id or vector")
+)
+
+// Term is one operand of an expression, the vector of a stored object or a literal vector
+// multiplied by Weight. Weight 0 means 1, and a negative one subtracts the vector.
+type Term struct {
+	ID     []byte
+	Vector []float64
+	Weight float32
+}
+
+func SearchByExpression(terms []Term, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return Get().SearchByExpression(terms, size, epsilon, opts...)
+}
+
+// SearchByExpression searches with the weighted sum of terms, e.g. king - man + woman.
+// The objects referred to by the terms are excluded from the results with WithExcludeSelf.
+func (s *Service) SearchByExpression(terms []Term, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return s.SearchByExpressionContext(context.Background(), terms, size, epsilon, opts...)
+}
+
+func SearchByExpressionContext(ctx context.Context, terms []Term, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return Get().SearchByExpressionContext(ctx, terms, size, epsilon, opts...)
+}
+
+// SearchByExpressionContext is SearchByExpression which stops with the error of ctx once ctx is done.
+func (s *Service) SearchByExpressionContext(ctx context.Context, terms []Term, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	s, leave := s.enter()
+	defer leave()
+	query, err := s.evaluate(ctx, terms)
+	if err != nil {
+		return nil, err
+	}
+	query, err = s.prepare(query)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := newSearchConfig(opts)
+	if cfg.excludeSelf {
+		for _, t := range terms {
+			if len(t.ID) > 0 {
+				cfg.excludeIDs(t.ID)
+			}
+		}
+	}
+	return s.search(ctx, query, size, epsilon, cfg)
+}
+
+// evaluate returns the weighted sum of terms. Literal vectors are validated and normalized like queries.
+func (s *Service) evaluate(ctx context.Context, terms []Term) ([]float64, error) {
+	if len(terms) == 0 {
+		return nil, ErrEmptyExpression
+	}
+	ret := make([]float64, s.ngtDim())
+	for _, t := range terms {
+		w := float64(t.Weight)
+		if w == 0 {
+			w = 1
+		}
+		switch {
+		case len(t.ID) > 0 && len(t.Vector) == 0:
+			v, err := s.getVector(ctx, t.ID)
+			if err != nil {
+				return nil, err
+			}
+			for i := range v {
+				ret[i] += v[i] * w
+			}
+		case len(t.ID) == 0 && len(t.Vector) > 0:
+			v, err := s.prepare(t.Vector)
+			if err != nil {
+				return nil, err
+			}
+			for i := range v {
+				ret[i] += v[i] * w
+			}
+		default:
+			return nil, ErrInvalidTerm
+		}
+	}
+	return ret, nil
+}
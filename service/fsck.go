@@ -0,0 +1,176 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"bytes"
+	"context"
+)
+
+const (
+	// InconsistencyDangling is an ID in the KVS whose object is not in NGT.
+	InconsistencyDangling = "dangling"
+	// InconsistencyReverse is an ID whose object maps back to another ID or to none.
+	InconsistencyReverse = "broken_reverse"
+	// InconsistencyShared is an ID whose object is owned by another ID, which maps back to it.
+	InconsistencyShared = "shared"
+	// InconsistencyOrphan is an object in NGT which no ID in the KVS points to.
+	InconsistencyOrphan = "orphan"
+
+	// fsckGap is how many missing objects in a row end the scan of NGT beyond the objects in the KVS.
+	fsckGap = 10000
+)
+
+// Inconsistency is a mismatch between NGT and the KVS.
+// ID is empty for an orphan, and Owner is the ID which Object maps back to, if any.
+type Inconsistency struct {
+	Kind   string
+	ID     []byte
+	Object uint
+	Owner  []byte
+}
+
+func Fsck(ctx context.Context, maxObject uint, f func(Inconsistency) error) error {
+	return Get().Fsck(ctx, maxObject, f)
+}
+
+// Fsck cross-checks every ID in the KVS and every object in NGT, and calls f for each inconsistency.
+// gongt cannot list its objects, so they are looked up from 1 to maxObject.
+// maxObject 0 means scanning until fsckGap objects in a row are missing beyond the largest one in the KVS.
+// The index and the KVS should not be written while checking.
+func (s *Service) Fsck(ctx context.Context, maxObject uint, f func(Inconsistency) error) error {
+	s, leave := s.enter()
+	defer leave()
+	var incs []Inconsistency
+	var largest uint
+	var err error
+	rerr := s.db.Range(func(key []byte, in uint) bool {
+		if err = ctx.Err(); err != nil {
+			return false
+		}
+		if in > largest {
+			largest = in
+		}
+		id := make([]byte, len(key))
+		copy(id, key)
+		if !s.hasObject(in) {
+			incs = append(incs, Inconsistency{Kind: InconsistencyDangling, ID: id, Object: in})
+			return true
+		}
+		owner, _ := s.db.GetKey(in)
+		if bytes.Equal(owner, id) {
+			return true
+		}
+		kind := InconsistencyReverse
+		if len(owner) != 0 {
+			if v, err := s.db.GetVal(owner); err == nil && v == in {
+				kind = InconsistencyShared
+			}
+		}
+		incs = append(incs, Inconsistency{Kind: kind, ID: id, Object: in, Owner: owner})
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if rerr != nil {
+		return rerr
+	}
+	// f is called out of Range since repairing in it may write the KVS.
+	for _, inc := range incs {
+		if err := f(inc); err != nil {
+			return err
+		}
+	}
+
+	missing := 0
+	for in := uint(1); maxObject == 0 || in <= maxObject; in++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !s.hasObject(in) {
+			missing++
+			if maxObject == 0 && in > largest && missing >= fsckGap {
+				return nil
+			}
+			continue
+		}
+		missing = 0
+		owner, _ := s.db.GetKey(in)
+		if len(owner) != 0 {
+			if v, err := s.db.GetVal(owner); err == nil && v == in {
+				continue
+			}
+		}
+		if err := f(Inconsistency{Kind: InconsistencyOrphan, Object: in, Owner: owner}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Repair(inc Inconsistency) error {
+	return Get().Repair(inc)
+}
+
+// Repair fixes inc found by Fsck. A dangling ID and an orphan are removed, a broken reverse mapping is rebuilt
+// and an ID sharing the object of another one gets its own copy of the vector, which is indexed by the next CreateIndex.
+// The reverse mapping of a removed orphan is left until its object number is reused.
+func (s *Service) Repair(inc Inconsistency) error {
+	s, leave := s.enter()
+	defer leave()
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+	switch inc.Kind {
+	case InconsistencyDangling:
+		return s.db.Delete(inc.ID)
+	case InconsistencyReverse:
+		// an earlier repair may have given the object to another ID sharing it.
+		if owner, _ := s.db.GetKey(inc.Object); len(owner) != 0 && !bytes.Equal(owner, inc.ID) {
+			if v, err := s.db.GetVal(owner); err == nil && v == inc.Object {
+				return s.copyObject(inc.ID, inc.Object)
+			}
+		}
+		return s.db.Set(inc.ID, inc.Object)
+	case InconsistencyShared:
+		return s.copyObject(inc.ID, inc.Object)
+	case InconsistencyOrphan:
+		return s.ngtRemove(inc.Object)
+	}
+	return nil
+}
+
+// copyObject inserts a copy of the object in and maps id to it.
+func (s *Service) copyObject(id []byte, in uint) error {
+	v, err := s.ngtVector(in)
+	if err != nil {
+		return err
+	}
+	vector := make([]float64, len(v))
+	for i, e := range v {
+		vector[i] = float64(e)
+	}
+	cp, err := s.ngtInsert(vector)
+	if err != nil {
+		return err
+	}
+	if err := s.db.Set(id, cp); err != nil {
+		s.ngtRemove(cp)
+		return err
+	}
+	return nil
+}
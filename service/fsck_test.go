@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/yahoojapan/gongt"
+)
+
+func TestFsck(t *testing.T) {
+	gongt.Get().SetObjectType(gongt.Float)
+	defer SetupWithTeardown(t)()
+
+	check := func() []string {
+		var ret []string
+		err := Fsck(context.Background(), 0, func(inc Inconsistency) error {
+			ret = append(ret, fmt.Sprintf("%s %s %d %s", inc.Kind, inc.ID, inc.Object, inc.Owner))
+			return Repair(inc)
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: TestFsck(%v)", err)
+		}
+		return ret
+	}
+
+	db := Get().db
+	db.Set([]byte("x"), 99)
+	db.Set([]byte("y"), 3)
+	db.Set([]byte("y"), 1)
+	orphan, _ := gongt.StrictInsert([]float64{1, 1, 0, 0, 0, 0})
+
+	want := []string{
+		"shared a 1 y",
+		"broken_reverse c 3 y",
+		"dangling x 99 ",
+		fmt.Sprintf("orphan  %d ", orphan),
+	}
+	if got := check(); !reflect.DeepEqual(got, want) {
+		t.Errorf("TestFsck: %q, wanted: %q", got, want)
+	}
+	if got := check(); len(got) != 0 {
+		t.Errorf("TestFsck: %q after repair, wanted: none", got)
+	}
+
+	for id, want := range map[string][]float32{
+		"a": {1, 0, 0, 0, 0, 0},
+		"c": {0, 0, 1, 0, 0, 0},
+		"y": {1, 0, 0, 0, 0, 0},
+	} {
+		obj, err := GetObject([]byte(id))
+		if err != nil || !reflect.DeepEqual(obj.Vector, want) {
+			t.Errorf("TestFsck(%v): %v, %v, wanted: %v", id, obj, err, want)
+		}
+	}
+	if _, err := GetObject([]byte("x")); err == nil {
+		t.Errorf("TestFsck(x): nil, wanted: not found")
+	}
+}
@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"fmt"
+	"time"
+)
+
+// Health reports whether the service can keep serving without losing data.
+type Health struct {
+	// Errors are the problems found: the last SaveIndex and the last background CreateIndex if they failed.
+	// The service is healthy if there are none. The errors recorded by NGT are left to GetErrors,
+	// as they pile up from every failed call.
+	Errors         []error
+	LastSaveIndex  time.Time
+	UnsavedObjects int
+	// AutoSave is the status of the background SaveIndex, nil if it is disabled.
+	AutoSave *AutoSaveStatus
+}
+
+// Healthy reports whether no problem is found.
+func (h *Health) Healthy() bool {
+	return len(h.Errors) == 0
+}
+
+func GetHealth() *Health {
+	return Get().GetHealth()
+}
+
+// GetHealth returns the current Health.
+func (s *Service) GetHealth() *Health {
+	s, leave := s.enter()
+	defer leave()
+	h := &Health{
+		AutoSave: s.AutoSaveStatus(),
+	}
+	o := s.stats
+	o.mu.Lock()
+	h.LastSaveIndex = o.lastSaveIndex
+	h.UnsavedObjects = len(o.unsaved)
+	if o.saveError != nil {
+		h.Errors = append(h.Errors, fmt.Errorf("save index: %v", o.saveError))
+	}
+	o.mu.Unlock()
+	if a := s.AutoIndexStatus(); a != nil && a.LastError != nil {
+		h.Errors = append(h.Errors, fmt.Errorf("auto index: %v", a.LastError))
+	}
+	return h
+}
@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"github.com/yahoojapan/gongt"
+)
+
+// The NGT index is accessed only through these methods, CreateIndex and SaveIndex, so imu coordinates them.
+// NGT is not safe for searches running while the object repository or the graph changes.
+// Inserts and removes are logged to the WAL outside imu, and their callers hold wmu to keep the order.
+
+// ngtSearch searches NGT under the shared lock.
+func (s *Service) ngtSearch(vector []float64, size int, epsilon, radius float32) ([]gongt.StrictSearchResult, error) {
+	s.imu.RLock()
+	defer s.imu.RUnlock()
+	return s.ngt.StrictSearch(vector, size, epsilon, radius)
+}
+
+// ngtVector returns the vector of the object under the shared lock.
+func (s *Service) ngtVector(in uint) ([]float32, error) {
+	s.imu.RLock()
+	defer s.imu.RUnlock()
+	return s.ngt.GetStrictVector(in)
+}
+
+// ngtDim returns the dimension of NGT under the shared lock.
+// gongt copies the whole NGT to read it, which races with writes without the lock.
+func (s *Service) ngtDim() int {
+	s.imu.RLock()
+	defer s.imu.RUnlock()
+	return s.ngt.GetDim()
+}
+
+// ngtPath returns the index path of NGT under the shared lock, for the same reason as ngtDim.
+func (s *Service) ngtPath() string {
+	s.imu.RLock()
+	defer s.imu.RUnlock()
+	return s.ngt.GetPath()
+}
+
+// ngtInsert inserts vector into NGT under the exclusive lock, counts it and
+// may wake up the background CreateIndex and SaveIndex.
+func (s *Service) ngtInsert(vector []float64) (uint, error) {
+	s.imu.Lock()
+	in, err := s.ngt.StrictInsert(vector)
+	s.imu.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	if err := s.logInsert(in, vector); err != nil {
+		// an insert which is not logged is not acknowledged
+		s.imu.Lock()
+		s.ngt.StrictRemove(in)
+		s.imu.Unlock()
+		return 0, err
+	}
+	pending, unsaved := s.stats.inserted(in)
+	s.notifyInserted(pending)
+	s.notifyChanged(unsaved)
+	return in, nil
+}
+
+// ngtRemove removes the object from NGT under the exclusive lock, counts it and may wake up the background SaveIndex.
+// It is logged first: a failed remove is rolled forward anyway, see reconcile.
+func (s *Service) ngtRemove(in uint) error {
+	if err := s.logRemove(in); err != nil {
+		return err
+	}
+	s.imu.Lock()
+	err := s.ngt.StrictRemove(in)
+	s.imu.Unlock()
+	if err != nil {
+		return err
+	}
+	s.notifyChanged(s.stats.removed(in))
+	return nil
+}
@@ -0,0 +1,198 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	JobRunning  = "running"
+	JobDone     = "done"
+	JobCanceled = "canceled"
+	JobFailed   = "failed"
+)
+
+var (
+	ErrJobNotFound = errors.New("job not found")
+
+	jobs   = make(map[string]*Job)
+	jobsMu sync.Mutex
+	lastID int
+)
+
+// Job is an admin task running in the background, such as finding duplicates.
+type Job struct {
+	ID   string
+	Kind string
+
+	mu       sync.Mutex
+	state    string
+	done     int
+	total    int
+	err      error
+	started  time.Time
+	finished time.Time
+	pairs    []DuplicatePair
+	// cancel is set before the job is added to jobs and never changes, so it is read without mu.
+	cancel context.CancelFunc
+}
+
+// JobStatus is a snapshot of Job.
+type JobStatus struct {
+	ID       string
+	Kind     string
+	State    string
+	Done     int
+	Total    int
+	Error    error
+	Started  time.Time
+	Finished time.Time
+	// Pairs is the result of a duplicates job, which is set once the job is done.
+	Pairs []DuplicatePair
+}
+
+func StartDuplicatesJob(threshold, epsilon float32) (*Job, error) {
+	return Get().StartDuplicatesJob(threshold, epsilon)
+}
+
+// StartDuplicatesJob runs FindDuplicates in the background.
+func (s *Service) StartDuplicatesJob(threshold, epsilon float32) (*Job, error) {
+	if threshold <= 0 {
+		return nil, ErrInvalidRadius
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	j := newJob("duplicates", cancel)
+	go func() {
+		var pairs []DuplicatePair
+		err := s.FindDuplicates(ctx, threshold, epsilon, j.progress, func(p DuplicatePair) error {
+			pairs = append(pairs, p)
+			return nil
+		})
+		j.finish(pairs, err)
+	}()
+	return j, nil
+}
+
+// newJob adds a running job which is stopped by cancel, so that it can be canceled as soon as it is found.
+func newJob(kind string, cancel context.CancelFunc) *Job {
+	jobsMu.Lock()
+	defer jobsMu.Unlock()
+	lastID++
+	j := &Job{
+		ID:      strconv.Itoa(lastID),
+		Kind:    kind,
+		state:   JobRunning,
+		started: time.Now(),
+		cancel:  cancel,
+	}
+	jobs[j.ID] = j
+	return j
+}
+
+func (j *Job) progress(done, total int) {
+	j.mu.Lock()
+	j.done, j.total = done, total
+	j.mu.Unlock()
+}
+
+func (j *Job) finish(pairs []DuplicatePair, err error) {
+	j.mu.Lock()
+	j.finished = time.Now()
+	switch {
+	case err == context.Canceled:
+		j.state = JobCanceled
+	case err != nil:
+		j.state = JobFailed
+		j.err = err
+	default:
+		j.state = JobDone
+		j.pairs = pairs
+	}
+	j.mu.Unlock()
+	j.cancel()
+}
+
+// Status returns the current state of the job.
+func (j *Job) Status() JobStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return JobStatus{
+		ID:       j.ID,
+		Kind:     j.Kind,
+		State:    j.state,
+		Done:     j.done,
+		Total:    j.total,
+		Error:    j.err,
+		Started:  j.started,
+		Finished: j.finished,
+		Pairs:    j.pairs,
+	}
+}
+
+// GetJob returns the job of id.
+func GetJob(id string) (*Job, error) {
+	jobsMu.Lock()
+	defer jobsMu.Unlock()
+	j, ok := jobs[id]
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+	return j, nil
+}
+
+// ListJobs returns all jobs which are running or kept after they finished, in the order they started.
+func ListJobs() []*Job {
+	jobsMu.Lock()
+	defer jobsMu.Unlock()
+	ret := make([]*Job, 0, len(jobs))
+	for _, j := range jobs {
+		ret = append(ret, j)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].started.Before(ret[j].started)
+	})
+	return ret
+}
+
+// CancelJob stops the job of id. The job is kept as canceled.
+func CancelJob(id string) error {
+	j, err := GetJob(id)
+	if err != nil {
+		return err
+	}
+	j.cancel()
+	return nil
+}
+
+// DeleteJob cancels the job if it is running, and forgets it and its result.
+func DeleteJob(id string) error {
+	jobsMu.Lock()
+	j, ok := jobs[id]
+	delete(jobs, id)
+	jobsMu.Unlock()
+	if !ok {
+		return ErrJobNotFound
+	}
+	j.cancel()
+	return nil
+}
@@ -0,0 +1,253 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/kpango/glg"
+)
+
+const (
+	opInsert  = "insert"
+	opRemove  = "remove"
+	opReplace = "replace"
+)
+
+// intent is a write operation which touches both NGT and the KVS.
+// Old and New are NGT object IDs before and after the operation.
+type intent struct {
+	Op      string `json:"op"`
+	ID      []byte `json:"id"`
+	Old     uint   `json:"old,omitempty"`
+	New     uint   `json:"new,omitempty"`
+	Meta    []byte `json:"meta,omitempty"`
+	OldMeta []byte `json:"old_meta,omitempty"`
+}
+
+// journal persists the intent in progress. Writes are serialized by Service,
+// so the file holds at most one intent and is emptied when the operation finishes.
+// A nil journal records nothing.
+type journal struct {
+	f *os.File
+}
+
+// openJournal opens the journal at path and returns the intent left by a previous process, if any.
+func openJournal(path string) (*journal, *intent, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, nil, err
+	}
+	b, err := ioutil.ReadAll(f)
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	j := &journal{f: f}
+	if len(b) == 0 {
+		return j, nil, nil
+	}
+	var it intent
+	if err := json.Unmarshal(b, &it); err != nil {
+		// the intent is synced before the operation starts, so a torn one was never acted on.
+		glg.Warnf("discard broken journal %s: %v", path, err)
+		return j, nil, nil
+	}
+	return j, &it, nil
+}
+
+func (j *journal) begin(it *intent) error {
+	if j == nil {
+		return nil
+	}
+	b, err := json.Marshal(it)
+	if err != nil {
+		return err
+	}
+	if err := j.f.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := j.f.WriteAt(b, 0); err != nil {
+		return err
+	}
+	return j.f.Sync()
+}
+
+func (j *journal) commit() error {
+	if j == nil {
+		return nil
+	}
+	if err := j.f.Truncate(0); err != nil {
+		return err
+	}
+	return j.f.Sync()
+}
+
+func (j *journal) close() error {
+	if j == nil {
+		return nil
+	}
+	return j.f.Close()
+}
+
+// JournalPath returns the path of the journal of the index, next to the index directory.
+func JournalPath(index string) string {
+	return filepath.Clean(index) + ".journal"
+}
+
+func OpenJournal(path string) error {
+	return Get().OpenJournal(path)
+}
+
+// OpenJournal records write operations in progress to path.
+// If the previous process stopped in the middle of one, NGT and the KVS are reconciled first,
+// and then the objects replayed by OpenWAL which no ID points to are removed.
+// CreateIndex and SaveIndex wait for writes, so NGT never persists an object the journal does not know.
+func (s *Service) OpenJournal(path string) error {
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+	j, it, err := openJournal(path)
+	if err != nil {
+		return err
+	}
+	if it != nil {
+		// the WAL may have given the objects other IDs than the intent has
+		if in, ok := s.replayed[it.Old]; ok && it.Old != 0 {
+			it.Old = in
+		}
+		if in, ok := s.replayed[it.New]; ok && it.New != 0 {
+			it.New = in
+		}
+		glg.Infof("reconcile interrupted %s of %s", it.Op, it.ID)
+		if err := s.reconcile(it); err != nil {
+			j.close()
+			return err
+		}
+		if err := j.commit(); err != nil {
+			j.close()
+			return err
+		}
+	}
+	if err := s.dropUnacknowledged(); err != nil {
+		j.close()
+		return err
+	}
+	s.journal.close()
+	s.journal = j
+	return nil
+}
+
+// dropUnacknowledged removes the objects replayed by OpenWAL which no ID points to. An insert is logged
+// before the journal records it, so such an object was never acknowledged. The stats count the objects
+// of the KVS, which never had it, so it is only marked as changed.
+func (s *Service) dropUnacknowledged() error {
+	for _, in := range s.replayed {
+		if !s.hasObject(in) {
+			continue
+		}
+		if key, _ := s.db.GetKey(in); len(key) != 0 {
+			continue
+		}
+		if err := s.logRemove(in); err != nil {
+			return err
+		}
+		s.imu.Lock()
+		err := s.ngt.StrictRemove(in)
+		s.imu.Unlock()
+		if err != nil {
+			return err
+		}
+		s.stats.changed([]uint{in})
+	}
+	s.replayed = nil
+	return nil
+}
+
+// abort reconciles the failed operation in process. The intent stays in the journal
+// if reconciling fails too, so that the next start retries it.
+func (s *Service) abort(it *intent, err error) error {
+	if rerr := s.reconcile(it); rerr != nil {
+		glg.Errorf("reconcile %s of %s: %v", it.Op, it.ID, rerr)
+		return err
+	}
+	s.journal.commit()
+	return err
+}
+
+// reconcile brings NGT and the KVS to a consistent state for an unfinished intent.
+// Inserts are rolled back and removes are rolled forward, since neither was acknowledged.
+// Replaces are rolled forward once the KVS stopped pointing to the old object.
+// Every step is idempotent, so it can be repeated after another crash.
+func (s *Service) reconcile(it *intent) error {
+	cur, _ := s.db.GetVal(it.ID)
+	switch it.Op {
+	case opInsert:
+		if cur != 0 && cur == it.New {
+			if err := s.db.Delete(it.ID); err != nil {
+				return err
+			}
+		}
+		return s.removeOrphan(it.New)
+	case opRemove:
+		if cur != 0 && cur == it.Old {
+			if err := s.db.Delete(it.ID); err != nil {
+				return err
+			}
+		}
+		return s.removeOrphan(it.Old)
+	case opReplace:
+		if cur != 0 && cur == it.Old {
+			return s.removeOrphan(it.New)
+		}
+		if cur == 0 {
+			if s.hasObject(it.New) {
+				cur = it.New
+			} else if s.hasObject(it.Old) {
+				return s.setObject(s.db, it.ID, it.Old, it.OldMeta)
+			} else {
+				return nil
+			}
+		}
+		if err := s.setObject(s.db, it.ID, cur, it.Meta); err != nil {
+			return err
+		}
+		return s.removeOrphan(it.Old)
+	}
+	return nil
+}
+
+func (s *Service) hasObject(in uint) bool {
+	if in == 0 {
+		return false
+	}
+	_, err := s.ngtVector(in)
+	return err == nil
+}
+
+// removeOrphan removes the NGT object unless some ID in the KVS points to it.
+func (s *Service) removeOrphan(in uint) error {
+	if !s.hasObject(in) {
+		return nil
+	}
+	if key, _ := s.db.GetKey(in); len(key) != 0 {
+		return nil
+	}
+	return s.ngtRemove(in)
+}
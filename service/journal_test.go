@@ -0,0 +1,154 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/yahoojapan/gongt"
+	"github.com/yahoojapan/ngtd/ngtdtest"
+)
+
+// failingMap is a KVS whose Set fails while fail is true.
+type failingMap struct {
+	*ngtdtest.Map
+	fail bool
+}
+
+func (m *failingMap) Set(key []byte, val uint) error {
+	if m.fail {
+		return errors.New("Set failed")
+	}
+	return m.Map.Set(key, val)
+}
+
+// SetupWithJournal opens a journal in a temporary directory.
+// If it is not nil, it is left in the journal beforehand as if the process crashed.
+func SetupWithJournal(t *testing.T, it *intent) func() {
+	dir, err := ioutil.TempDir("", "ngtd-journal")
+	if err != nil {
+		t.Fatalf("Unexpected error: SetupWithJournal(%v)", err)
+	}
+	path := filepath.Join(dir, "journal")
+	if it != nil {
+		b, _ := json.Marshal(it)
+		if err := ioutil.WriteFile(path, b, 0644); err != nil {
+			t.Fatalf("Unexpected error: SetupWithJournal(%v)", err)
+		}
+	}
+	if err := OpenJournal(path); err != nil {
+		t.Fatalf("Unexpected error: SetupWithJournal(%v)", err)
+	}
+	return func() {
+		Get().journal.close()
+		Get().journal = nil
+		os.RemoveAll(dir)
+	}
+}
+
+func TestJournal(t *testing.T) {
+	t.Run("TestRollbackInsert", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		defer SetupWithJournal(t, nil)()
+		db := &failingMap{Map: Get().db.(*ngtdtest.Map), fail: true}
+		SetDB(db)
+
+		if err := Insert([]float64{1, 1, 0, 0, 0, 0}, []byte("g")); err == nil {
+			t.Errorf("TestRollbackInsert: nil, wanted: error")
+		}
+		if Get().hasObject(7) {
+			t.Errorf("TestRollbackInsert: object 7 is left in NGT")
+		}
+		if b, _ := ioutil.ReadFile(Get().journal.f.Name()); len(b) != 0 {
+			t.Errorf("TestRollbackInsert: %s is left in journal", b)
+		}
+
+		db.fail = false
+		if err := Insert([]float64{1, 1, 0, 0, 0, 0}, []byte("g")); err != nil {
+			t.Errorf("Unexpected error: TestRollbackInsert(%v)", err)
+		}
+	})
+
+	t.Run("TestReconcile", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			setup func() *intent
+			id    string
+			want  []float32
+			gone  []uint
+		}{
+			{
+				"insert",
+				func() *intent {
+					in, _ := gongt.StrictInsert([]float64{1, 1, 0, 0, 0, 0})
+					Get().db.Set([]byte("g"), in)
+					return &intent{Op: opInsert, ID: []byte("g"), New: in}
+				},
+				"g", nil, []uint{7},
+			},
+			{
+				"remove",
+				func() *intent {
+					Get().db.Delete([]byte("a"))
+					return &intent{Op: opRemove, ID: []byte("a"), Old: 1}
+				},
+				"a", nil, []uint{1},
+			},
+			{
+				"replace before remap",
+				func() *intent {
+					in, _ := gongt.StrictInsert([]float64{1, 1, 0, 0, 0, 0})
+					return &intent{Op: opReplace, ID: []byte("a"), Old: 1, New: in}
+				},
+				"a", []float32{1, 0, 0, 0, 0, 0}, []uint{7},
+			},
+			{
+				"replace in remap",
+				func() *intent {
+					in, _ := gongt.StrictInsert([]float64{1, 1, 0, 0, 0, 0})
+					Get().db.Delete([]byte("a"))
+					return &intent{Op: opReplace, ID: []byte("a"), Old: 1, New: in}
+				},
+				"a", []float32{1, 1, 0, 0, 0, 0}, []uint{1},
+			},
+		}
+		for _, tt := range tests {
+			func() {
+				defer SetupWithTeardown(t)()
+				defer SetupWithJournal(t, tt.setup())()
+
+				obj, err := GetObject([]byte(tt.id))
+				if tt.want == nil && err == nil {
+					t.Errorf("TestReconcile(%v): %v, wanted: not found", tt.name, obj)
+				} else if tt.want != nil && (err != nil || !reflect.DeepEqual(obj.Vector, tt.want)) {
+					t.Errorf("TestReconcile(%v): %v, %v, wanted: %v", tt.name, obj, err, tt.want)
+				}
+				for _, in := range tt.gone {
+					if Get().hasObject(in) {
+						t.Errorf("TestReconcile(%v): object %d is left in NGT", tt.name, in)
+					}
+				}
+			}()
+		}
+	})
+}
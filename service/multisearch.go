@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+var (
+	searchWorkers = runtime.NumCPU()
+	// searchSlots bounds the queries running at once over all MultiSearch calls to searchWorkers.
+	searchSlots = make(chan struct{}, searchWorkers)
+)
+
+// SearchQuery is one query of MultiSearch. Vector is searched if set, otherwise the vector of ID.
+type SearchQuery struct {
+	Vector  []float64
+	ID      []byte
+	Size    int
+	Epsilon float32
+	Options []SearchOption
+}
+
+type MultiSearchResult struct {
+	Result []SearchResult
+	Error  error
+}
+
+// SetSearchWorkers sets the number of queries MultiSearch runs in parallel over all requests. n <= 0 means the number of CPUs.
+// It is meant to be called before serving.
+func SetSearchWorkers(n int) {
+	if n <= 0 {
+		n = runtime.NumCPU()
+	}
+	searchWorkers = n
+	searchSlots = make(chan struct{}, n)
+}
+
+func MultiSearch(queries []SearchQuery) []MultiSearchResult {
+	return Get().MultiSearch(queries)
+}
+
+// MultiSearch runs queries on the workers shared by all MultiSearch calls and returns the results in the order of queries.
+// A failed query does not affect the others.
+func (s *Service) MultiSearch(queries []SearchQuery) []MultiSearchResult {
+	return s.MultiSearchContext(context.Background(), queries)
+}
+
+func MultiSearchContext(ctx context.Context, queries []SearchQuery) []MultiSearchResult {
+	return Get().MultiSearchContext(ctx, queries)
+}
+
+// MultiSearchContext is MultiSearch whose queries fail with the error of ctx once ctx is done.
+func (s *Service) MultiSearchContext(ctx context.Context, queries []SearchQuery) []MultiSearchResult {
+	s, leave := s.enter()
+	defer leave()
+	ret := make([]MultiSearchResult, len(queries))
+	slots := searchSlots
+	wg := &sync.WaitGroup{}
+	for i := range queries {
+		// the slot is taken before the goroutine starts, so waiting queries cost nothing
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			ret[i].Error = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			q := queries[i]
+			if len(q.Vector) == 0 && len(q.ID) > 0 {
+				ret[i].Result, ret[i].Error = s.SearchByIDContext(ctx, q.ID, q.Size, q.Epsilon, q.Options...)
+			} else {
+				ret[i].Result, ret[i].Error = s.SearchContext(ctx, q.Vector, q.Size, q.Epsilon, q.Options...)
+			}
+		}(i)
+	}
+	wg.Wait()
+	return ret
+}
@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yahoojapan/gongt"
+)
+
+func TestMultiSearch(t *testing.T) {
+	defer SetupWithTeardown(t)()
+	defer SetSearchWorkers(0)
+	SetSearchWorkers(2)
+
+	ids := []string{"a", "b", "c", "d", "e", "f"}
+	queries := make([]SearchQuery, 0, len(ids)*2+1)
+	for i, id := range ids {
+		v := make([]float64, len(ids))
+		v[i] = 1
+		queries = append(queries,
+			SearchQuery{Vector: v, Size: 1, Epsilon: gongt.DefaultEpsilon},
+			SearchQuery{ID: []byte(id), Size: 1, Epsilon: gongt.DefaultEpsilon})
+	}
+	queries = append(queries, SearchQuery{ID: []byte("g"), Size: 1, Epsilon: gongt.DefaultEpsilon})
+
+	res := MultiSearch(queries)
+	if len(res) != len(queries) {
+		t.Fatalf("TestMultiSearch: %d results, wanted: %d", len(res), len(queries))
+	}
+	for i, id := range ids {
+		for _, r := range res[i*2 : i*2+2] {
+			if r.Error != nil {
+				t.Errorf("Unexpected error: TestMultiSearch(%v)", r.Error)
+			} else if len(r.Result) != 1 || string(r.Result[0].Id) != id {
+				t.Errorf("TestMultiSearch(%v): %v, wanted: %v", id, r.Result, id)
+			}
+		}
+	}
+	if res[len(res)-1].Error == nil {
+		t.Errorf("TestMultiSearch(g): %v, wanted: error", res[len(res)-1].Result)
+	}
+}
+
+func TestMultiSearchSlots(t *testing.T) {
+	defer SetupWithTeardown(t)()
+	defer SetSearchWorkers(0)
+	SetSearchWorkers(2)
+
+	// other MultiSearch calls hold every slot
+	searchSlots <- struct{}{}
+	searchSlots <- struct{}{}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	for i, r := range MultiSearchContext(ctx, []SearchQuery{{ID: []byte("a"), Size: 1}, {ID: []byte("b"), Size: 1}}) {
+		if r.Error != context.DeadlineExceeded {
+			t.Errorf("TestMultiSearchSlots(%v): %v, %v, wanted: %v", i, r.Result, r.Error, context.DeadlineExceeded)
+		}
+	}
+
+	<-searchSlots
+	if res := MultiSearch([]SearchQuery{{ID: []byte("a"), Size: 1, Epsilon: gongt.DefaultEpsilon}}); res[0].Error != nil {
+		t.Errorf("Unexpected error: TestMultiSearchSlots(%v)", res[0].Error)
+	}
+}
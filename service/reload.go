@@ -0,0 +1,234 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/kpango/glg"
+	"github.com/yahoojapan/gongt"
+	"github.com/yahoojapan/ngtd/kvs"
+)
+
+var (
+	ErrReloadDisabled  = errors.New("reload is disabled")
+	ErrReloadNotFound  = errors.New("index or KVS to reload not found")
+	ErrReloadServed    = errors.New("index or KVS to reload is already served")
+	ErrReloadDimension = errors.New("dimension of the index to reload differs")
+
+	reload = &reloader{warmUp: 100}
+)
+
+// reloader swaps the index and the KVS of the default service. mu serializes reloads with Shutdown.
+type reloader struct {
+	mu    sync.Mutex
+	newDB func(string) (kvs.KVS, error)
+	// index and db are the paths given by EnableReload, which are reloaded when Reload gets empty ones.
+	index, db string
+	// served are index and db of the default service with symbolic links resolved.
+	served [2]string
+	warmUp int
+}
+
+// EnableReload lets Reload swap the default index and KVS for others while serving.
+// index and db are the paths of the ones served now, and newDB opens the KVS at the given path.
+func EnableReload(index, db string, newDB func(string) (kvs.KVS, error)) error {
+	served, err := resolve(index, db)
+	if err != nil {
+		return err
+	}
+	reload.mu.Lock()
+	defer reload.mu.Unlock()
+	reload.newDB = newDB
+	reload.index, reload.db = index, db
+	reload.served = served
+	return nil
+}
+
+// SetReloadWarmUp sets the number of IDs searched on the new index before Reload swaps it in.
+func SetReloadWarmUp(n int) {
+	reload.mu.Lock()
+	reload.warmUp = n
+	reload.mu.Unlock()
+}
+
+// Reload opens the index and the KVS at the given paths, warms them up and swaps them in for the default ones.
+// Empty paths mean the ones given by EnableReload, which may be symbolic links switched to a new build.
+// Calls already running on the old index finish on it, and it is closed once they are done.
+// Writes to the old index which are not in the new one are lost.
+func Reload(index, db string) error {
+	reload.mu.Lock()
+	defer reload.mu.Unlock()
+	if reload.newDB == nil {
+		return ErrReloadDisabled
+	}
+	if index == "" {
+		index = reload.index
+	}
+	if db == "" {
+		db = reload.db
+	}
+
+	start := time.Now()
+	srv, served, err := reload.open(index, db)
+	if err != nil {
+		return err
+	}
+	reload.served = served
+
+	old := Get()
+	old.StopAutoIndex()
+	old.StopAutoSave()
+	smu.Lock()
+	s = srv
+	smu.Unlock()
+	srv.StartAutoIndex(autoIndexConfig)
+	srv.StartAutoSave(autoSaveConfig)
+	glg.Infof("reloaded %s and %s in %v", index, db, time.Since(start))
+
+	drained := old.retire(srv)
+	go func() {
+		<-drained
+		if err := old.Close(); err != nil {
+			glg.Errorf("cannot close the index replaced by %s: %v", index, err)
+			return
+		}
+		glg.Infof("closed the index replaced by %s", index)
+	}()
+	return nil
+}
+
+// open opens the service to be swapped in for the default one and warms it up. The caller holds mu.
+func (r *reloader) open(index, db string) (*Service, [2]string, error) {
+	paths, err := resolve(index, db)
+	if err != nil {
+		return nil, paths, err
+	}
+	if paths[0] == r.served[0] || paths[1] == r.served[1] {
+		return nil, paths, ErrReloadServed
+	}
+	// gongt creates an index at a path with nothing
+	if files, err := ioutil.ReadDir(paths[0]); err != nil || len(files) == 0 {
+		return nil, paths, ErrReloadNotFound
+	}
+
+	kv, err := r.newDB(paths[1])
+	if err != nil {
+		return nil, paths, err
+	}
+	ngt := gongt.New(paths[0]).Open()
+	if errs := ngt.GetErrors(); len(errs) > 0 {
+		ngt.Close()
+		kv.Close()
+		return nil, paths, fmt.Errorf("%v", errs)
+	}
+
+	old := Get()
+	srv := NewServiceWithNGT(ngt, kv)
+	srv.distanceType = old.distanceType
+	srv.normalize = old.normalize
+	err = srv.OpenWAL(WALPath(paths[0]))
+	if err == nil {
+		err = srv.OpenJournal(JournalPath(paths[0]))
+	}
+	if err == nil && srv.GetDim() != old.GetDim() {
+		err = ErrReloadDimension
+	}
+	if err == nil {
+		err = srv.warmUp(r.warmUp)
+	}
+	if err != nil {
+		srv.Close()
+		return nil, paths, err
+	}
+	return srv, paths, nil
+}
+
+// resolve returns the paths of the index and the KVS with symbolic links resolved.
+func resolve(index, db string) ([2]string, error) {
+	var ret [2]string
+	for i, p := range []string{index, db} {
+		r, err := filepath.EvalSymlinks(p)
+		if err != nil {
+			return ret, ErrReloadNotFound
+		}
+		ret[i], err = filepath.Abs(r)
+		if err != nil {
+			return ret, err
+		}
+	}
+	return ret, nil
+}
+
+// warmUp searches the first n IDs of the KVS by themselves. It pages the graph in,
+// and fails if an ID of the KVS has no object in the index.
+func (s *Service) warmUp(n int) error {
+	if n <= 0 {
+		return nil
+	}
+	ids, _, err := s.ListIDs(nil, nil, n)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if _, err := s.SearchByID(id, 10, gongt.DefaultEpsilon); err != nil {
+			return fmt.Errorf("warm-up search of %s: %v", id, err)
+		}
+	}
+	return nil
+}
+
+// enter counts a call running on s until the returned func is called.
+// Once s is replaced by Reload, every new call runs on the replacing service instead,
+// and only the calls already running on s keep it open.
+func (s *Service) enter() (*Service, func()) {
+	s.rmu.Lock()
+	if s.next != nil {
+		next := s.next
+		s.rmu.Unlock()
+		return next.enter()
+	}
+	s.refs++
+	s.rmu.Unlock()
+	return s, s.leave
+}
+
+func (s *Service) leave() {
+	s.rmu.Lock()
+	s.refs--
+	if s.next != nil && s.refs == 0 {
+		close(s.drained)
+	}
+	s.rmu.Unlock()
+}
+
+// retire makes s hand new calls over to next, and returns a channel closed once the calls running on s are done.
+func (s *Service) retire(next *Service) <-chan struct{} {
+	s.rmu.Lock()
+	defer s.rmu.Unlock()
+	s.next = next
+	s.drained = make(chan struct{})
+	if s.refs == 0 {
+		close(s.drained)
+	}
+	return s.drained
+}
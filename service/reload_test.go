@@ -0,0 +1,204 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yahoojapan/gongt"
+	"github.com/yahoojapan/ngtd/kvs"
+	"github.com/yahoojapan/ngtd/ngtdtest"
+)
+
+// buildIndex saves an index of the objects at dir/name, and returns the KVS of them,
+// which is the KVS at dir/name.kvs for newDB of Reload.
+func buildIndex(t *testing.T, dir, name string, objects map[string][]float64) *ngtdtest.Map {
+	db := ngtdtest.NewMap()
+	dim := 0
+	for _, v := range objects {
+		dim = len(v)
+	}
+	srv := NewServiceWithNGT(gongt.New(filepath.Join(dir, name)).SetDimension(dim).SetObjectType(gongt.Float).Open(), db)
+	for id, v := range objects {
+		if err := srv.Insert(v, []byte(id)); err != nil {
+			t.Fatalf("Unexpected error: buildIndex(%v)", err)
+		}
+	}
+	if err := srv.CreateIndex(1); err != nil {
+		t.Fatalf("Unexpected error: buildIndex(%v)", err)
+	}
+	if err := srv.SaveIndex(); err != nil {
+		t.Fatalf("Unexpected error: buildIndex(%v)", err)
+	}
+	srv.ngt.Close()
+	if err := ioutil.WriteFile(filepath.Join(dir, name+".kvs"), nil, 0644); err != nil {
+		t.Fatalf("Unexpected error: buildIndex(%v)", err)
+	}
+	return db
+}
+
+func TestReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ngtd-reload")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestReload(%v)", err)
+	}
+	defer os.RemoveAll(dir)
+
+	dbs := map[string]*ngtdtest.Map{
+		"v1":     buildIndex(t, dir, "v1", map[string][]float64{"a": {1, 0, 0, 0, 0, 0}, "b": {0, 1, 0, 0, 0, 0}}),
+		"v2":     buildIndex(t, dir, "v2", map[string][]float64{"a": {0, 0, 1, 0, 0, 0}, "g": {1, 1, 0, 0, 0, 0}}),
+		"v3":     buildIndex(t, dir, "v3", map[string][]float64{"a": {1, 0, 0}}),
+		"broken": buildIndex(t, dir, "broken", map[string][]float64{"a": {1, 0, 0, 0, 0, 0}}),
+	}
+	// x has no object in the index, which the warm-up finds
+	dbs["broken"].Set([]byte("x"), 100)
+	newDB := func(p string) (kvs.KVS, error) {
+		return dbs[strings.TrimSuffix(filepath.Base(p), ".kvs")], nil
+	}
+	link := func(name string) {
+		for _, p := range []string{"index", "index.kvs"} {
+			os.Remove(filepath.Join(dir, p))
+			if err := os.Symlink(filepath.Join(dir, name+filepath.Ext(p)), filepath.Join(dir, p)); err != nil {
+				t.Fatalf("Unexpected error: TestReload(%v)", err)
+			}
+		}
+	}
+
+	// the default service is replaced by the one of v1 during the test
+	link("v1")
+	first := NewServiceWithNGT(gongt.New(filepath.Join(dir, "v1")).Open(), dbs["v1"])
+	orig := Get()
+	smu.Lock()
+	s = first
+	smu.Unlock()
+	defer func() {
+		Get().Close()
+		smu.Lock()
+		s = orig
+		smu.Unlock()
+		reload = &reloader{warmUp: 100}
+	}()
+
+	if err := Reload("", ""); err != ErrReloadDisabled {
+		t.Errorf("TestReload: %v, wanted: %v", err, ErrReloadDisabled)
+	}
+	if err := EnableReload(filepath.Join(dir, "index"), filepath.Join(dir, "index.kvs"), newDB); err != nil {
+		t.Fatalf("Unexpected error: TestReload(%v)", err)
+	}
+
+	t.Run("TestRejected", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			index string
+			db    string
+			want  error
+		}{
+			{"served", "", "", ErrReloadServed},
+			{"served index", "v1", "v2.kvs", ErrReloadServed},
+			{"not found", "v4", "v2.kvs", ErrReloadNotFound},
+			{"dimension", "v3", "v3.kvs", ErrReloadDimension},
+			{"broken", "broken", "broken.kvs", nil},
+		}
+		for _, tt := range tests {
+			index, db := tt.index, tt.db
+			if index != "" {
+				index, db = filepath.Join(dir, index), filepath.Join(dir, db)
+			}
+			err := Reload(index, db)
+			if err == nil || (tt.want != nil && err != tt.want) {
+				t.Errorf("TestRejected(%v): %v, wanted: %v", tt.name, err, tt.want)
+			}
+			if Get() != first {
+				t.Fatalf("TestRejected(%v): the default service is replaced", tt.name)
+			}
+		}
+	})
+
+	t.Run("TestSwap", func(t *testing.T) {
+		// a call running on v1 keeps it open
+		_, leave := first.enter()
+		link("v2")
+		if err := Reload("", ""); err != nil {
+			t.Fatalf("Unexpected error: TestSwap(%v)", err)
+		}
+		if Get() == first {
+			t.Fatalf("TestSwap: the default service is not replaced")
+		}
+		obj, err := GetObject([]byte("g"))
+		if err != nil || !reflect.DeepEqual(obj.Vector, []float32{1, 1, 0, 0, 0, 0}) {
+			t.Errorf("TestSwap: %v, %v, wanted: g of v2", obj, err)
+		}
+
+		// a new call runs on v2 even while v1 is in use
+		obj, err = first.GetObject([]byte("a"))
+		if err != nil || !reflect.DeepEqual(obj.Vector, []float32{0, 0, 1, 0, 0, 0}) {
+			t.Errorf("TestSwap: %v, %v, wanted: a of v2 while v1 is in use", obj, err)
+		}
+		select {
+		case <-first.drained:
+			t.Errorf("TestSwap: v1 is drained while in use")
+		default:
+		}
+
+		leave()
+		select {
+		case <-first.drained:
+		case <-time.After(time.Second):
+			t.Fatalf("TestSwap: v1 is not drained")
+		}
+		obj, err = first.GetObject([]byte("a"))
+		if err != nil || !reflect.DeepEqual(obj.Vector, []float32{0, 0, 1, 0, 0, 0}) {
+			t.Errorf("TestSwap: %v, %v, wanted: a of v2 after v1 is drained", obj, err)
+		}
+
+		if err := Reload(filepath.Join(dir, "v2"), filepath.Join(dir, "v1.kvs")); err != ErrReloadServed {
+			t.Errorf("TestSwap: %v, wanted: %v", err, ErrReloadServed)
+		}
+	})
+
+	t.Run("TestConcurrent", func(t *testing.T) {
+		// package-level calls race with Reload unless they read the default service under the lock
+		started := make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 100; i++ {
+				if i == 1 {
+					close(started)
+				}
+				if _, err := GetObject([]byte("a")); err != nil {
+					t.Errorf("Unexpected error: TestConcurrent(%v)", err)
+				}
+				Count()
+				runtime.Gosched()
+			}
+		}()
+		<-started
+		link("v1")
+		if err := Reload("", ""); err != nil {
+			t.Errorf("Unexpected error: TestConcurrent(%v)", err)
+		}
+		<-done
+	})
+}
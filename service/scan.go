@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"errors"
+)
+
+const (
+	// DefaultListLimit is the number of IDs in a page of ListIDs if the limit is not given.
+	DefaultListLimit = 1000
+	// MaxListLimit is the maximum number of IDs in a page of ListIDs.
+	MaxListLimit = 10000
+)
+
+var (
+	ErrInvalidLimit = errors.New("limit must be between 0 and 10000")
+)
+
+func ListIDs(cursor, prefix []byte, limit int) ([][]byte, []byte, error) {
+	return Get().ListIDs(cursor, prefix, limit)
+}
+
+// ListIDs returns a page of stored IDs with prefix from cursor, and the cursor of the next page.
+// An empty cursor starts from the beginning, and an empty next cursor means the last page.
+// limit 0 means DefaultListLimit.
+func (s *Service) ListIDs(cursor, prefix []byte, limit int) ([][]byte, []byte, error) {
+	return s.ListIDsContext(context.Background(), cursor, prefix, limit)
+}
+
+func ListIDsContext(ctx context.Context, cursor, prefix []byte, limit int) ([][]byte, []byte, error) {
+	return Get().ListIDsContext(ctx, cursor, prefix, limit)
+}
+
+// ListIDsContext is ListIDs which stops with the error of ctx once ctx is done.
+func (s *Service) ListIDsContext(ctx context.Context, cursor, prefix []byte, limit int) ([][]byte, []byte, error) {
+	s, leave := s.enter()
+	defer leave()
+	if limit < 0 || limit > MaxListLimit {
+		return nil, nil, ErrInvalidLimit
+	}
+	if limit == 0 {
+		limit = DefaultListLimit
+	}
+	return s.dbContext(ctx).Scan(cursor, prefix, limit)
+}
+
+func Count() (int, error) {
+	return Get().Count()
+}
+
+// Count returns the number of stored objects.
+func (s *Service) Count() (int, error) {
+	return s.CountContext(context.Background())
+}
+
+func CountContext(ctx context.Context) (int, error) {
+	return Get().CountContext(ctx)
+}
+
+// CountContext is Count which stops with the error of ctx once ctx is done.
+func (s *Service) CountContext(ctx context.Context) (int, error) {
+	s, leave := s.enter()
+	defer leave()
+	return s.dbContext(ctx).Count()
+}
@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	// ScoreModeDistance leaves the distance as it is and sets no score.
+	ScoreModeDistance = "distance"
+	// ScoreModeSimilarity converts the distance into a similarity in [0, 1] suited to the distance type:
+	// (1 + cosine similarity) / 2 for cosine, 1 - angle / pi for angle and 1 / (1 + d) for the others.
+	ScoreModeSimilarity = "similarity"
+	// ScoreModeInverse converts the distance d into 1 / (1 + d) whatever the distance type is.
+	ScoreModeInverse = "inverse"
+)
+
+var (
+	ErrUnsupportedScoreMode = errors.New("unsupported score mode")
+
+	defaultScoreMode = ScoreModeDistance
+)
+
+// SetDefaultScoreMode sets the score mode of searches which do not specify one.
+func SetDefaultScoreMode(mode string) error {
+	mode, err := ScoreMode(mode)
+	if err != nil {
+		return err
+	}
+	defaultScoreMode = mode
+	return nil
+}
+
+// ScoreMode returns the score mode applied for mode. Empty mode means the default one.
+func ScoreMode(mode string) (string, error) {
+	switch mode {
+	case "":
+		return defaultScoreMode, nil
+	case ScoreModeDistance, ScoreModeSimilarity, ScoreModeInverse:
+		return mode, nil
+	}
+	return "", ErrUnsupportedScoreMode
+}
+
+// WithScoreMode sets Score of the results converted from Distance. Empty mode means the default one.
+func WithScoreMode(mode string) SearchOption {
+	return func(c *searchConfig) {
+		c.scoreMode = mode
+	}
+}
+
+// toScoreFunc returns the conversion of mode for distances under metric, or nil for ScoreModeDistance.
+func toScoreFunc(mode, metric string) func(float32) float32 {
+	switch mode {
+	case ScoreModeDistance:
+		return nil
+	case ScoreModeSimilarity:
+		switch metric {
+		case "cosine":
+			return func(d float32) float32 {
+				return clamp(1 - d/2)
+			}
+		case "angle":
+			return func(d float32) float32 {
+				return clamp(1 - d/math.Pi)
+			}
+		}
+	}
+	return func(d float32) float32 {
+		return 1 / (1 + float32(math.Max(0, float64(d))))
+	}
+}
+
+func clamp(x float32) float32 {
+	return float32(math.Max(0, math.Min(1, float64(x))))
+}
@@ -0,0 +1,396 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/yahoojapan/ngtd/filter"
+)
+
+const (
+	// DefaultMaxCandidates is the upper limit of candidates fetched from NGT while filtering results.
+	// It also limits the number of results of range search.
+	DefaultMaxCandidates = 10000
+
+	// rangeCandidates is the number of candidates fetched first by range search.
+	rangeCandidates = 100
+)
+
+var (
+	ErrInvalidRadius = errors.New("invalid radius for range search")
+)
+
+// SearchOption configures optional behavior of Search and SearchByID.
+type SearchOption func(*searchConfig)
+
+type searchConfig struct {
+	withMeta      bool
+	filter        *filter.Filter
+	maxCandidates int
+	radius        float32
+	excludeSelf   bool
+	exclude       map[string]struct{}
+	rerank        int
+	rerankMetric  string
+	stats         *SearchStats
+	truncated     *bool
+	scoreMode     string
+}
+
+// SearchStats reports how a search went. Durations are per stage.
+type SearchStats struct {
+	// Search is the time of the approximate search including KVS lookups and filtering.
+	Search time.Duration
+	// Rerank is the time of recomputing exact distances.
+	Rerank time.Duration
+	Total  time.Duration
+	// Candidates is the number of objects fetched from NGT in the last round.
+	Candidates int
+}
+
+// WithMeta attaches the metadata stored with each object to the results.
+func WithMeta(withMeta bool) SearchOption {
+	return func(c *searchConfig) {
+		c.withMeta = withMeta
+	}
+}
+
+// WithFilter restricts results to objects whose metadata matches f.
+// Search widens the candidates until enough results match or the candidate limit is hit.
+func WithFilter(f *filter.Filter) SearchOption {
+	return func(c *searchConfig) {
+		c.filter = f
+	}
+}
+
+// WithMaxCandidates sets the upper limit of candidates fetched while filtering. n <= 0 means DefaultMaxCandidates.
+func WithMaxCandidates(n int) SearchOption {
+	return func(c *searchConfig) {
+		if n > 0 {
+			c.maxCandidates = n
+		}
+	}
+}
+
+// WithRadius limits results to objects within radius from the query.
+// radius 0 keeps only exact matches and a negative radius means unlimited.
+func WithRadius(radius float32) SearchOption {
+	return func(c *searchConfig) {
+		if radius < 0 {
+			radius = -1.0
+		}
+		c.radius = radius
+	}
+}
+
+// WithExcludeSelf drops the query object from the results of SearchByID and SearchRangeByID,
+// and the objects in the expression from the results of SearchByExpression.
+func WithExcludeSelf(excludeSelf bool) SearchOption {
+	return func(c *searchConfig) {
+		c.excludeSelf = excludeSelf
+	}
+}
+
+// WithExcludeIDs drops objects of ids from the results.
+func WithExcludeIDs(ids [][]byte) SearchOption {
+	return func(c *searchConfig) {
+		c.excludeIDs(ids...)
+	}
+}
+
+func (c *searchConfig) excludeIDs(ids ...[]byte) {
+	if c.exclude == nil {
+		c.exclude = make(map[string]struct{}, len(ids))
+	}
+	for _, id := range ids {
+		c.exclude[string(id)] = struct{}{}
+	}
+}
+
+// WithRerank fetches candidates approximate neighbors, recomputes their exact distances
+// from the stored vectors under metric and returns the nearest ones.
+// Empty metric means the distance type of the index. candidates <= 0 disables re-ranking.
+func WithRerank(candidates int, metric string) SearchOption {
+	return func(c *searchConfig) {
+		c.rerank = candidates
+		c.rerankMetric = metric
+	}
+}
+
+// WithStats fills stats with the timings of the search.
+func WithStats(stats *SearchStats) SearchOption {
+	return func(c *searchConfig) {
+		c.stats = stats
+	}
+}
+
+// WithTruncated sets truncated to whether a search by radius stopped at the candidate limit
+// while more objects within the radius may exist, so that a range search can report a partial result.
+func WithTruncated(truncated *bool) SearchOption {
+	return func(c *searchConfig) {
+		c.truncated = truncated
+	}
+}
+
+func newSearchConfig(opts []SearchOption) *searchConfig {
+	c := &searchConfig{
+		maxCandidates: DefaultMaxCandidates,
+		radius:        -1.0,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// filtered reports whether some candidates may be dropped, so that NGT has to return more than size.
+func (c *searchConfig) filtered() bool {
+	return c.filter != nil || len(c.exclude) > 0
+}
+
+func (c *searchConfig) excluded(id []byte) bool {
+	_, ok := c.exclude[string(id)]
+	return ok
+}
+
+func SearchRange(vector []float64, radius, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return Get().SearchRange(vector, radius, epsilon, opts...)
+}
+
+// SearchRange returns all objects within radius from vector, nearest first, up to the candidate limit.
+// WithTruncated reports whether the limit cut the result short.
+func (s *Service) SearchRange(vector []float64, radius, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return s.SearchRangeContext(context.Background(), vector, radius, epsilon, opts...)
+}
+
+func SearchRangeContext(ctx context.Context, vector []float64, radius, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return Get().SearchRangeContext(ctx, vector, radius, epsilon, opts...)
+}
+
+// SearchRangeContext is SearchRange which stops with the error of ctx once ctx is done.
+func (s *Service) SearchRangeContext(ctx context.Context, vector []float64, radius, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	s, leave := s.enter()
+	defer leave()
+	vector, err := s.prepare(vector)
+	if err != nil {
+		return nil, err
+	}
+	return s.searchRange(ctx, vector, radius, epsilon, newSearchConfig(opts))
+}
+
+func (s *Service) searchRange(ctx context.Context, vector []float64, radius, epsilon float32, cfg *searchConfig) ([]SearchResult, error) {
+	if radius < 0 {
+		return nil, ErrInvalidRadius
+	}
+	cfg.radius = radius
+	return s.search(ctx, vector, cfg.maxCandidates, epsilon, cfg)
+}
+
+func SearchRangeByID(id []byte, radius, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return Get().SearchRangeByID(id, radius, epsilon, opts...)
+}
+
+// SearchRangeByID returns all objects within radius from the object of id.
+func (s *Service) SearchRangeByID(id []byte, radius, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return s.SearchRangeByIDContext(context.Background(), id, radius, epsilon, opts...)
+}
+
+func SearchRangeByIDContext(ctx context.Context, id []byte, radius, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return Get().SearchRangeByIDContext(ctx, id, radius, epsilon, opts...)
+}
+
+// SearchRangeByIDContext is SearchRangeByID which stops with the error of ctx once ctx is done.
+func (s *Service) SearchRangeByIDContext(ctx context.Context, id []byte, radius, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	s, leave := s.enter()
+	defer leave()
+	v, err := s.getVector(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.searchRange(ctx, v, radius, epsilon, newSearchByIDConfig(id, opts))
+}
+
+// newSearchByIDConfig returns searchConfig which drops id itself if WithExcludeSelf is set.
+func newSearchByIDConfig(id []byte, opts []SearchOption) *searchConfig {
+	cfg := newSearchConfig(opts)
+	if cfg.excludeSelf {
+		cfg.excludeIDs(id)
+	}
+	return cfg
+}
+
+// search returns size results, re-ranked and scored if requested, and records stats.
+func (s *Service) search(ctx context.Context, vector []float64, size int, epsilon float32, cfg *searchConfig) ([]SearchResult, error) {
+	metric := s.distanceType
+	var dist distanceFunc
+	if cfg.rerank > 0 {
+		if cfg.rerankMetric != "" {
+			metric = cfg.rerankMetric
+		}
+		var err error
+		if dist, err = toDistanceFunc(metric); err != nil {
+			return nil, err
+		}
+	}
+	mode, err := ScoreMode(cfg.scoreMode)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	ret, err := s.candidates(ctx, vector, max(size, cfg.rerank), epsilon, cfg)
+	if err != nil {
+		return nil, err
+	}
+	searched := time.Now()
+	if dist != nil {
+		if ret, err = s.rerank(vector, ret, size, dist); err != nil {
+			return nil, err
+		}
+	}
+	if score := toScoreFunc(mode, metric); score != nil {
+		for i := range ret {
+			ret[i].Score = score(ret[i].Distance)
+		}
+	}
+	if cfg.stats != nil {
+		end := time.Now()
+		cfg.stats.Search = searched.Sub(start)
+		cfg.stats.Rerank = end.Sub(searched)
+		cfg.stats.Total = end.Sub(start)
+	}
+	return ret, nil
+}
+
+// rerank replaces distances of results with exact ones and returns the nearest size results.
+func (s *Service) rerank(vector []float64, results []SearchResult, size int, dist distanceFunc) ([]SearchResult, error) {
+	for i := range results {
+		v, err := s.ngtVector(uint(results[i].objectID))
+		if err != nil {
+			return nil, err
+		}
+		results[i].Distance = dist(vector, v)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Distance < results[j].Distance
+	})
+	return results[:min(size, len(results))], nil
+}
+
+// candidates fetches candidates from NGT and keeps the acceptable ones.
+// While results are filtered or searched by range, the number of candidates doubles until size results are found,
+// NGT has no more objects within the radius, or maxCandidates is reached. It stops between the rounds once ctx is done.
+func (s *Service) candidates(ctx context.Context, vector []float64, size int, epsilon float32, cfg *searchConfig) ([]SearchResult, error) {
+	k := size
+	if cfg.filter != nil {
+		k = min(size*2, cfg.maxCandidates)
+	} else if cfg.filtered() {
+		// every excluded object may be in the results
+		k = min(size+len(cfg.exclude), cfg.maxCandidates)
+	}
+	if cfg.radius >= 0 {
+		k = min(k, rangeCandidates)
+	}
+	metas := make(map[uint32][]byte)
+	db := s.dbContext(ctx)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result, err := s.ngtSearch(vector, k, epsilon, cfg.radius)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.stats != nil {
+			cfg.stats.Candidates = len(result)
+		}
+
+		vals := make([]uint, len(result))
+		for i, v := range result {
+			vals[i] = uint(v.ID)
+		}
+		ids, err := db.GetKeys(vals)
+		if err != nil {
+			return nil, err
+		}
+
+		ret := make([]SearchResult, 0, min(size, len(result)))
+		checked := 0
+		for i, id := range ids {
+			checked = i + 1
+			if cfg.excluded(id) {
+				continue
+			}
+			r := SearchResult{
+				Id:       id,
+				Distance: result[i].Distance,
+				Error:    nil,
+				objectID: result[i].ID,
+			}
+			if cfg.withMeta || cfg.filter != nil {
+				meta, ok := metas[result[i].ID]
+				if !ok {
+					meta, r.Error = db.GetMeta(id)
+					if r.Error != nil && cfg.filter != nil {
+						return nil, r.Error
+					} else if r.Error == nil {
+						metas[result[i].ID] = meta
+					}
+				}
+				r.Meta = meta
+			}
+			if cfg.filter != nil && !cfg.filter.Match(r.Meta) {
+				continue
+			}
+			if !cfg.withMeta {
+				r.Meta = nil
+			}
+			ret = append(ret, r)
+			if len(ret) == size {
+				break
+			}
+		}
+
+		if len(ret) >= size || len(result) < k || k >= cfg.maxCandidates {
+			if cfg.truncated != nil {
+				// NGT may have more objects within the radius, or some candidates were not checked
+				*cfg.truncated = cfg.radius >= 0 && (len(result) == k || checked < len(result))
+			}
+			return ret, nil
+		}
+		k = min(k*2, cfg.maxCandidates)
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
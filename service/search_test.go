@@ -0,0 +1,392 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/yahoojapan/gongt"
+	"github.com/yahoojapan/ngtd/filter"
+)
+
+// SetupWithMeta inserts objects g0..g9 next to a, where odd ones are in category "odd".
+func SetupWithMeta(t *testing.T) func() {
+	teardown := SetupWithTeardown(t)
+	for i := 0; i < 10; i++ {
+		meta := fmt.Sprintf(`{"category":"even","rank":%d}`, i)
+		if i%2 == 1 {
+			meta = fmt.Sprintf(`{"category":"odd","rank":%d}`, i)
+		}
+		v := []float64{1, float64(i+1) / 100, 0, 0, 0, 0}
+		if err := InsertWithMeta(v, []byte(fmt.Sprintf("g%d", i)), []byte(meta)); err != nil {
+			t.Fatalf("Unexpected error: SetupWithMeta(%v)", err)
+		}
+	}
+	if err := CreateIndex(1); err != nil {
+		t.Fatalf("Unexpected error: SetupWithMeta(%v)", err)
+	}
+	return teardown
+}
+
+func TestSearchWithFilter(t *testing.T) {
+	defer SetupWithMeta(t)()
+
+	tests := []struct {
+		expr          string
+		size          int
+		maxCandidates int
+		want          []string
+	}{
+		{`category == "odd"`, 3, 0, []string{"g1", "g3", "g5"}},
+		{`category == "even" AND rank >= 4`, 2, 0, []string{"g4", "g6"}},
+		{`rank == 9`, 1, 0, []string{"g9"}},
+		{`category == "none"`, 3, 0, []string{}},
+		{`category == "odd"`, 5, 4, []string{"g1"}},
+	}
+	for _, tt := range tests {
+		f, err := filter.Parse(tt.expr)
+		if err != nil {
+			t.Fatalf("Unexpected error: TestSearchWithFilter(%v)", err)
+		}
+		res, err := Search([]float64{1, 0, 0, 0, 0, 0}, tt.size, gongt.DefaultEpsilon, WithFilter(f), WithMaxCandidates(tt.maxCandidates))
+		if err != nil {
+			t.Errorf("Unexpected error: TestSearchWithFilter(%v)", err)
+		}
+		got := make([]string, len(res))
+		for i, r := range res {
+			got[i] = string(r.Id)
+			if r.Meta != nil {
+				t.Errorf("TestSearchWithFilter(%v): meta %s is returned without WithMeta", tt.expr, r.Meta)
+			}
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("TestSearchWithFilter(%v): %v, wanted: %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestSearchRange(t *testing.T) {
+	defer SetupWithMeta(t)()
+
+	q := []float64{1, 0, 0, 0, 0, 0}
+	tests := []struct {
+		name string
+		f    func() ([]SearchResult, error)
+		want []string
+	}{
+		{"range", func() ([]SearchResult, error) {
+			return SearchRange(q, 0.055, gongt.DefaultEpsilon)
+		}, []string{"a", "g0", "g1", "g2", "g3", "g4"}},
+		{"range by id", func() ([]SearchResult, error) {
+			return SearchRangeByID([]byte("g0"), 0.015, gongt.DefaultEpsilon)
+		}, []string{"g0", "a", "g1"}},
+		{"range with max candidates", func() ([]SearchResult, error) {
+			return SearchRange(q, 0.055, gongt.DefaultEpsilon, WithMaxCandidates(3))
+		}, []string{"a", "g0", "g1"}},
+		{"top-k with radius", func() ([]SearchResult, error) {
+			return Search(q, 5, gongt.DefaultEpsilon, WithRadius(0.015))
+		}, []string{"a", "g0"}},
+		{"top-k without radius", func() ([]SearchResult, error) {
+			return Search(q, 2, gongt.DefaultEpsilon, WithRadius(-1))
+		}, []string{"a", "g0"}},
+		{"top-k with radius 0", func() ([]SearchResult, error) {
+			return Search(q, 5, gongt.DefaultEpsilon, WithRadius(0))
+		}, []string{"a"}},
+		{"range with radius 0", func() ([]SearchResult, error) {
+			return SearchRange(q, 0, gongt.DefaultEpsilon)
+		}, []string{"a"}},
+	}
+	for _, tt := range tests {
+		res, err := tt.f()
+		if err != nil {
+			t.Errorf("Unexpected error: TestSearchRange(%v)", err)
+		}
+		got := make([]string, len(res))
+		for i, r := range res {
+			got[i] = string(r.Id)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("TestSearchRange(%v): %v, wanted: %v", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := SearchRange(q, -1, gongt.DefaultEpsilon); err != ErrInvalidRadius {
+		t.Errorf("TestSearchRange: %v, wanted: %v", err, ErrInvalidRadius)
+	}
+
+	for _, n := range []int{3, 0} {
+		var truncated bool
+		if _, err := SearchRange(q, 0.055, gongt.DefaultEpsilon, WithMaxCandidates(n), WithTruncated(&truncated)); err != nil {
+			t.Errorf("Unexpected error: TestSearchRange(%v)", err)
+		}
+		if truncated != (n == 3) {
+			t.Errorf("TestSearchRange(truncated with max candidates %v): %v, wanted: %v", n, truncated, n == 3)
+		}
+	}
+}
+
+func TestSearchExclude(t *testing.T) {
+	defer SetupWithMeta(t)()
+
+	tests := []struct {
+		name string
+		f    func() ([]SearchResult, error)
+		want []string
+	}{
+		{"by id", func() ([]SearchResult, error) {
+			return SearchByID([]byte("g0"), 3, gongt.DefaultEpsilon)
+		}, []string{"g0", "a", "g1"}},
+		{"exclude self", func() ([]SearchResult, error) {
+			return SearchByID([]byte("g0"), 3, gongt.DefaultEpsilon, WithExcludeSelf(true))
+		}, []string{"a", "g1", "g2"}},
+		{"exclude self and ids", func() ([]SearchResult, error) {
+			return SearchByID([]byte("g0"), 3, gongt.DefaultEpsilon, WithExcludeSelf(true), WithExcludeIDs([][]byte{[]byte("a"), []byte("g2")}))
+		}, []string{"g1", "g3", "g4"}},
+		{"exclude ids with filter", func() ([]SearchResult, error) {
+			f, _ := filter.Parse(`category == "odd"`)
+			return Search([]float64{1, 0, 0, 0, 0, 0}, 2, gongt.DefaultEpsilon, WithFilter(f), WithExcludeIDs([][]byte{[]byte("g1")}))
+		}, []string{"g3", "g5"}},
+		{"range exclude self", func() ([]SearchResult, error) {
+			return SearchRangeByID([]byte("g0"), 0.015, gongt.DefaultEpsilon, WithExcludeSelf(true))
+		}, []string{"a", "g1"}},
+		{"exclude self by vector", func() ([]SearchResult, error) {
+			return Search([]float64{1, 0, 0, 0, 0, 0}, 1, gongt.DefaultEpsilon, WithExcludeSelf(true))
+		}, []string{"a"}},
+	}
+	for _, tt := range tests {
+		res, err := tt.f()
+		if err != nil {
+			t.Errorf("Unexpected error: TestSearchExclude(%v)", err)
+		}
+		got := make([]string, len(res))
+		for i, r := range res {
+			got[i] = string(r.Id)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("TestSearchExclude(%v): %v, wanted: %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSearchRerank(t *testing.T) {
+	defer SetupWithMeta(t)()
+
+	// g9 is the nearest in l2 but g2 has the nearest direction.
+	q := []float64{3, 0.1, 0, 0, 0, 0}
+	tests := []struct {
+		name string
+		opts []SearchOption
+		want string
+	}{
+		{"without rerank", nil, "g9"},
+		{"rerank by index distance", []SearchOption{WithRerank(16, "")}, "g9"},
+		{"rerank by cosine", []SearchOption{WithRerank(16, "cosine")}, "g2"},
+		{"rerank by angle", []SearchOption{WithRerank(16, "angle")}, "g2"},
+	}
+	for _, tt := range tests {
+		var stats SearchStats
+		res, err := Search(q, 1, gongt.DefaultEpsilon, append(tt.opts, WithStats(&stats))...)
+		if err != nil {
+			t.Errorf("Unexpected error: TestSearchRerank(%v)", err)
+			continue
+		}
+		if len(res) != 1 || string(res[0].Id) != tt.want {
+			t.Errorf("TestSearchRerank(%v): %v, wanted: %v", tt.name, res, tt.want)
+		}
+		wantCandidates := 1
+		if tt.opts != nil {
+			wantCandidates = 16
+		}
+		if stats.Candidates != wantCandidates || stats.Total < stats.Search {
+			t.Errorf("TestSearchRerank(%v): %+v, wanted: %d candidates", tt.name, stats, wantCandidates)
+		}
+	}
+
+	res, err := Search(q, 1, gongt.DefaultEpsilon, WithRerank(3, "l1"))
+	if err != nil || len(res) != 1 || res[0].Distance != l1(q, []float32{1, 0.1, 0, 0, 0, 0}) {
+		t.Errorf("TestSearchRerank: %v, %v, wanted: exact l1 distance", res, err)
+	}
+	res, err = Search(q, 1, gongt.DefaultEpsilon, WithRerank(3, "hamming"))
+	if err != nil || len(res) != 1 || res[0].Distance != hamming(q, []float32{1, 0.1, 0, 0, 0, 0}) {
+		t.Errorf("TestSearchRerank: %v, %v, wanted: exact hamming distance", res, err)
+	}
+	if _, err := Search(q, 1, gongt.DefaultEpsilon, WithRerank(3, "jaccard")); err != ErrUnsupportedDistanceType {
+		t.Errorf("TestSearchRerank: %v, wanted: %v", err, ErrUnsupportedDistanceType)
+	}
+}
+
+func TestSetDistanceType(t *testing.T) {
+	defer SetupWithMeta(t)()
+	defer SetDistanceType("l2")
+
+	if err := SetDistanceType("jaccard"); err != ErrUnsupportedDistanceType {
+		t.Errorf("TestSetDistanceType: %v, wanted: %v", err, ErrUnsupportedDistanceType)
+	}
+	if err := SetDistanceType("cosine"); err != nil {
+		t.Fatalf("Unexpected error: TestSetDistanceType(%v)", err)
+	}
+	// re-ranking by the index distance follows the distance type of the index.
+	res, err := Search([]float64{3, 0.1, 0, 0, 0, 0}, 1, gongt.DefaultEpsilon, WithRerank(16, ""))
+	if err != nil || len(res) != 1 || string(res[0].Id) != "g2" {
+		t.Errorf("TestSetDistanceType: %v, %v, wanted: g2", res, err)
+	}
+}
+
+func TestSearchScore(t *testing.T) {
+	defer SetupWithMeta(t)()
+	defer SetDefaultScoreMode(ScoreModeDistance)
+
+	q := []float64{1, 0, 0, 0, 0, 0}
+	tests := []struct {
+		name string
+		def  string
+		opts []SearchOption
+		want []float32
+	}{
+		{"distance", ScoreModeDistance, nil, []float32{0, 0}},
+		{"inverse", ScoreModeDistance, []SearchOption{WithScoreMode(ScoreModeInverse)}, []float32{1, 1 / 1.01}},
+		{"similarity of l2", ScoreModeDistance, []SearchOption{WithScoreMode(ScoreModeSimilarity)}, []float32{1, 1 / 1.01}},
+		{"default", ScoreModeInverse, nil, []float32{1, 1 / 1.01}},
+		{"similarity of cosine", ScoreModeDistance, []SearchOption{WithScoreMode(ScoreModeSimilarity), WithRerank(2, "cosine")}, []float32{1, 1 - cosine(q, []float32{1, 0.01, 0, 0, 0, 0})/2}},
+	}
+	for _, tt := range tests {
+		if err := SetDefaultScoreMode(tt.def); err != nil {
+			t.Fatalf("Unexpected error: TestSearchScore(%v)", err)
+		}
+		res, err := Search(q, 2, gongt.DefaultEpsilon, tt.opts...)
+		if err != nil {
+			t.Errorf("Unexpected error: TestSearchScore(%v)", err)
+			continue
+		}
+		for i, r := range res {
+			if d := r.Score - tt.want[i]; d > 1e-6 || d < -1e-6 {
+				t.Errorf("TestSearchScore(%v): %v, wanted: %v", tt.name, r.Score, tt.want[i])
+			}
+		}
+	}
+
+	if _, err := Search(q, 1, gongt.DefaultEpsilon, WithScoreMode("rank")); err != ErrUnsupportedScoreMode {
+		t.Errorf("TestSearchScore: %v, wanted: %v", err, ErrUnsupportedScoreMode)
+	}
+	if err := SetDefaultScoreMode("rank"); err != ErrUnsupportedScoreMode {
+		t.Errorf("TestSearchScore: %v, wanted: %v", err, ErrUnsupportedScoreMode)
+	}
+}
+
+func TestSearchByExamples(t *testing.T) {
+	defer SetupWithTeardown(t)()
+	if err := Insert([]float64{1, 1, 0, 0, 0, 0}, []byte("g")); err != nil {
+		t.Fatalf("Unexpected error: TestSearchByExamples(%v)", err)
+	}
+	if err := Insert([]float64{1, 0, 1, 0, 0, 0}, []byte("h")); err != nil {
+		t.Fatalf("Unexpected error: TestSearchByExamples(%v)", err)
+	}
+	if err := CreateIndex(1); err != nil {
+		t.Fatalf("Unexpected error: TestSearchByExamples(%v)", err)
+	}
+
+	a, b := Example{ID: []byte("a")}, Example{ID: []byte("b")}
+	tests := []struct {
+		name     string
+		positive []Example
+		negative []Example
+		want     string
+		err      error
+	}{
+		{"centroid", []Example{a, b}, nil, "g", nil},
+		{"weighted", []Example{{ID: []byte("a"), Weight: 3}, b}, nil, "g", nil},
+		{"negative", []Example{a}, []Example{b}, "h", nil},
+		{"no positive", nil, []Example{b}, "", ErrNoPositiveExample},
+		{"negative weight", []Example{{ID: []byte("a"), Weight: -1}}, nil, "", ErrInvalidWeight},
+	}
+	for _, tt := range tests {
+		res, err := SearchByExamples(tt.positive, tt.negative, 3, gongt.DefaultEpsilon)
+		if err != tt.err {
+			t.Errorf("TestSearchByExamples(%v): %v, wanted: %v", tt.name, err, tt.err)
+			continue
+		}
+		if tt.err != nil {
+			continue
+		}
+		if len(res) == 0 || string(res[0].Id) != tt.want {
+			t.Errorf("TestSearchByExamples(%v): %v, wanted: %v first", tt.name, res, tt.want)
+		}
+		for _, r := range res {
+			if string(r.Id) == "a" || string(r.Id) == "b" {
+				t.Errorf("TestSearchByExamples(%v): example %s is in the results", tt.name, r.Id)
+			}
+		}
+	}
+}
+
+func TestSearchByExpression(t *testing.T) {
+	defer SetupWithTeardown(t)()
+	if err := Insert([]float64{1, 1, 0, 0, 0, 0}, []byte("g")); err != nil {
+		t.Fatalf("Unexpected error: TestSearchByExpression(%v)", err)
+	}
+	if err := Insert([]float64{1, 0, 1, 0, 0, 0}, []byte("h")); err != nil {
+		t.Fatalf("Unexpected error: TestSearchByExpression(%v)", err)
+	}
+	if err := CreateIndex(1); err != nil {
+		t.Fatalf("Unexpected error: TestSearchByExpression(%v)", err)
+	}
+
+	tests := []struct {
+		name  string
+		terms []Term
+		want  string
+		err   bool
+	}{
+		{"g - b + c", []Term{{ID: []byte("g")}, {ID: []byte("b"), Weight: -1}, {ID: []byte("c")}}, "h", false},
+		{"literal", []Term{{ID: []byte("a")}, {Vector: []float64{0, 0.5, 0, 0, 0, 0}, Weight: 2}}, "g", false},
+		{"empty", nil, "", true},
+		{"id and vector", []Term{{ID: []byte("a"), Vector: []float64{0, 1, 0, 0, 0, 0}}}, "", true},
+		{"dimension mismatch", []Term{{Vector: []float64{0, 1}}}, "", true},
+		{"unknown id", []Term{{ID: []byte("z")}}, "", true},
+	}
+	for _, tt := range tests {
+		res, err := SearchByExpression(tt.terms, 1, gongt.DefaultEpsilon)
+		if tt.err {
+			if err == nil {
+				t.Errorf("TestSearchByExpression(%v): %v, wanted: error", tt.name, res)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error: TestSearchByExpression(%v)", err)
+		} else if len(res) != 1 || string(res[0].Id) != tt.want || res[0].Distance != 0 {
+			t.Errorf("TestSearchByExpression(%v): %v, wanted: %v", tt.name, res, tt.want)
+		}
+	}
+
+	// the objects in the expression are returned unless WithExcludeSelf is set.
+	terms := []Term{{ID: []byte("g")}, {ID: []byte("h")}, {ID: []byte("h"), Weight: -1}}
+	res, err := SearchByExpression(terms, 1, gongt.DefaultEpsilon)
+	if err != nil || len(res) != 1 || string(res[0].Id) != "g" {
+		t.Errorf("TestSearchByExpression: %v, %v, wanted: g", res, err)
+	}
+	res, err = SearchByExpression(terms, 10, gongt.DefaultEpsilon, WithExcludeSelf(true))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSearchByExpression(%v)", err)
+	}
+	for _, r := range res {
+		if id := string(r.Id); id == "g" || id == "h" {
+			t.Errorf("TestSearchByExpression: %v is returned with WithExcludeSelf", id)
+		}
+	}
+}
@@ -17,32 +17,71 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/yahoojapan/gongt"
 	"github.com/yahoojapan/ngtd/kvs"
+	"github.com/yahoojapan/ngtd/wal"
 )
 
 type Service struct {
-	db kvs.KVS
+	ngt *gongt.NGT
+	db  kvs.KVS
+	// distanceType is the distance type of ngt, one of the names accepted by collections.
+	distanceType string
+	normalize    bool
+	// wmu serializes writes so that an ID is never seen half replaced. It is taken before imu.
+	wmu sync.Mutex
+	// imu guards ngt. Searches share it, while an insert or a remove of an NGT object holds it exclusively
+	// for the call, and CreateIndex and SaveIndex for the whole build or save.
+	imu     sync.RWMutex
+	journal *journal
+	// replayed maps the object IDs logged in the WAL to the ones the replay gave them, 0 for those gone,
+	// so that OpenJournal reconciles the objects the intent left by the previous process refers to
+	// and rolls back the replayed ones no ID points to.
+	replayed map[uint]uint
+	// wal logs the inserts and removes of NGT objects since the last save.
+	wal   *wal.Log
+	stats *objectStats
+	// amu guards autoIndex and autoSave.
+	amu       sync.Mutex
+	autoIndex *autoIndexer
+	autoSave  *autoSaver
+	// rmu guards refs, the number of calls running on s, and next, the service replacing s by Reload.
+	rmu     sync.Mutex
+	refs    int
+	next    *Service
+	drained chan struct{}
 }
 
 type SearchResult struct {
 	Id       []byte
 	Distance float32
+	// Score is converted from Distance unless the score mode is ScoreModeDistance.
+	Score    float32
+	Meta     []byte
 	Error    error
+	objectID uint32
 }
 
 type GetObjectResult struct {
 	Id     []byte
 	Vector []float32
+	Meta   []byte
 }
 
 var (
+	// ErrIDNotFound is returned by Update, Remove and the searches by ID when the ID is not in DB.
+	ErrIDNotFound = errors.New("ID not found")
+
 	once = &sync.Once{}
-	s    *Service
+	// smu guards s, which Reload replaces. Package-level functions read s by Get.
+	smu sync.RWMutex
+	s   *Service
 )
 
 func init() {
@@ -53,62 +92,100 @@ func Get() *Service {
 	once.Do(func() {
 		s = NewService(nil)
 	})
+	smu.RLock()
+	defer smu.RUnlock()
 	return s
 }
 
+// SetDB sets the KVS of the index and counts its objects.
 func (s *Service) SetDB(db kvs.KVS) {
 	s.db = db
+	s.resetObjectStats()
 }
 
 func SetDB(db kvs.KVS) {
-	s.db = db
+	Get().SetDB(db)
 }
 
 func NewService(db kvs.KVS) *Service {
-	return &Service{
-		db: db,
+	return NewServiceWithNGT(gongt.Get(), db)
+}
+
+// NewServiceWithNGT returns Service backed by the given NGT index instead of the global one.
+func NewServiceWithNGT(ngt *gongt.NGT, db kvs.KVS) *Service {
+	s := &Service{
+		ngt:          ngt,
+		db:           db,
+		distanceType: "l2",
+		stats:        newObjectStats(),
 	}
+	s.resetObjectStats()
+	return s
+}
+
+func Search(vector []float64, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return Get().Search(vector, size, epsilon, opts...)
+}
+
+func (s *Service) Search(vector []float64, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return s.SearchContext(context.Background(), vector, size, epsilon, opts...)
 }
 
-func Search(vector []float64, size int, epsilon float32) ([]SearchResult, error) {
-	return s.Search(vector, size, epsilon)
+func SearchContext(ctx context.Context, vector []float64, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return Get().SearchContext(ctx, vector, size, epsilon, opts...)
 }
 
-func (s *Service) Search(vector []float64, size int, epsilon float32) ([]SearchResult, error) {
-	result, err := gongt.StrictSearch(vector, size, epsilon, -1.0)
+// SearchContext is Search which stops with the error of ctx once ctx is done.
+func (s *Service) SearchContext(ctx context.Context, vector []float64, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	s, leave := s.enter()
+	defer leave()
+	vector, err := s.prepare(vector)
 	if err != nil {
 		return nil, err
 	}
+	return s.search(ctx, vector, size, epsilon, newSearchConfig(opts))
+}
 
-	vals := make([]uint, len(result))
-	for i, v := range result {
-		vals[i] = uint(v.ID)
-	}
-	ids, err := s.db.GetKeys(vals)
+func SearchByID(id []byte, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return Get().SearchByID(id, size, epsilon, opts...)
+}
+
+func (s *Service) SearchByID(id []byte, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return s.SearchByIDContext(context.Background(), id, size, epsilon, opts...)
+}
+
+func SearchByIDContext(ctx context.Context, id []byte, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	return Get().SearchByIDContext(ctx, id, size, epsilon, opts...)
+}
+
+// SearchByIDContext is SearchByID which stops with the error of ctx once ctx is done.
+func (s *Service) SearchByIDContext(ctx context.Context, id []byte, size int, epsilon float32, opts ...SearchOption) ([]SearchResult, error) {
+	s, leave := s.enter()
+	defer leave()
+	v, err := s.getVector(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	ret := make([]SearchResult, len(result))
-	for i, id := range ids {
-		ret[i] = SearchResult{
-			Id:       id,
-			Distance: result[i].Distance,
-			Error:    nil,
-		}
-	}
-	return ret, nil
+	return s.search(ctx, v, size, epsilon, newSearchByIDConfig(id, opts))
 }
 
-func SearchByID(id []byte, size int, epsilon float32) ([]SearchResult, error) {
-	return s.SearchByID(id, size, epsilon)
+// dbContext returns the KVS whose calls stop once ctx is done.
+func (s *Service) dbContext(ctx context.Context) kvs.KVS {
+	if ctx.Done() == nil {
+		// ctx is never done
+		return s.db
+	}
+	return s.db.WithContext(ctx)
 }
 
-func (s *Service) SearchByID(id []byte, size int, epsilon float32) ([]SearchResult, error) {
-	in, err := s.db.GetVal(id)
-	if err != nil {
+func (s *Service) getVector(ctx context.Context, id []byte) ([]float64, error) {
+	in, err := s.dbContext(ctx).GetVal(id)
+	if err == kvs.ErrNotFound {
+		return nil, ErrIDNotFound
+	} else if err != nil {
 		return nil, err
 	}
-	vector, err := gongt.GetStrictVector(in)
+	vector, err := s.ngtVector(in)
 	if err != nil {
 		return nil, err
 	}
@@ -116,62 +193,367 @@ func (s *Service) SearchByID(id []byte, size int, epsilon float32) ([]SearchResu
 	for i, e := range vector {
 		v[i] = float64(e)
 	}
-	return s.Search(v, size, epsilon)
+	return v, nil
 }
 
 func Insert(vector []float64, id []byte) error {
-	return s.Insert(vector, id)
+	return Get().Insert(vector, id)
 }
 
 func (s *Service) Insert(vector []float64, id []byte) error {
-	i, _ := s.db.GetVal(id)
+	return s.InsertWithMeta(vector, id, nil)
+}
+
+func InsertContext(ctx context.Context, vector []float64, id []byte) error {
+	return Get().InsertContext(ctx, vector, id)
+}
+
+// InsertContext is Insert which stops with the error of ctx once ctx is done.
+func (s *Service) InsertContext(ctx context.Context, vector []float64, id []byte) error {
+	return s.InsertWithMetaContext(ctx, vector, id, nil)
+}
+
+func InsertWithMeta(vector []float64, id, meta []byte) error {
+	return Get().InsertWithMeta(vector, id, meta)
+}
+
+// InsertWithMeta inserts vector and stores meta with it. Empty meta is not stored.
+func (s *Service) InsertWithMeta(vector []float64, id, meta []byte) error {
+	return s.InsertWithMetaContext(context.Background(), vector, id, meta)
+}
+
+func InsertWithMetaContext(ctx context.Context, vector []float64, id, meta []byte) error {
+	return Get().InsertWithMetaContext(ctx, vector, id, meta)
+}
+
+// InsertWithMetaContext is InsertWithMeta which stops with the error of ctx once ctx is done.
+// A write stopped in the middle is rolled back.
+func (s *Service) InsertWithMetaContext(ctx context.Context, vector []float64, id, meta []byte) error {
+	s, leave := s.enter()
+	defer leave()
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+	i, err := s.dbContext(ctx).GetVal(id)
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
 	if i != 0 {
 		return errors.New("ID already exists")
 	}
-	in, err := gongt.StrictInsert(vector)
+	return s.insert(ctx, vector, id, meta)
+}
+
+func (s *Service) insert(ctx context.Context, vector []float64, id, meta []byte) error {
+	vector, err := s.prepare(vector)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	in, err := s.ngtInsert(vector)
 	if err != nil {
 		return err
 	}
 
-	return s.db.Set(id, in)
+	it := &intent{Op: opInsert, ID: id, New: in}
+	if err := s.journal.begin(it); err != nil {
+		s.ngtRemove(in)
+		return err
+	}
+	if err := s.setObject(s.dbContext(ctx), id, in, meta); err != nil {
+		return s.abort(it, err)
+	}
+	return s.journal.commit()
 }
 
-func Remove(id []byte) error {
-	return s.Remove(id)
+// setObject maps id to the NGT object and stores meta with it in db. Empty meta is not stored.
+func (s *Service) setObject(db kvs.KVS, id []byte, in uint, meta []byte) error {
+	if err := db.Set(id, in); err != nil {
+		return err
+	}
+	if len(meta) == 0 {
+		return nil
+	}
+	return db.SetMeta(id, meta)
 }
 
-func (s *Service) Remove(id []byte) error {
-	in, err := s.db.GetVal(id)
+func Upsert(vector []float64, id, meta []byte) (bool, error) {
+	return Get().Upsert(vector, id, meta)
+}
+
+// Upsert inserts vector, or replaces the vector behind id if it already exists.
+// It reports whether an existing vector was replaced.
+// On replace, empty meta keeps the metadata stored before.
+func (s *Service) Upsert(vector []float64, id, meta []byte) (bool, error) {
+	return s.UpsertContext(context.Background(), vector, id, meta)
+}
+
+func UpsertContext(ctx context.Context, vector []float64, id, meta []byte) (bool, error) {
+	return Get().UpsertContext(ctx, vector, id, meta)
+}
+
+// UpsertContext is Upsert which stops with the error of ctx once ctx is done.
+// A write stopped in the middle is rolled back.
+func (s *Service) UpsertContext(ctx context.Context, vector []float64, id, meta []byte) (bool, error) {
+	s, leave := s.enter()
+	defer leave()
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+	old, err := s.dbContext(ctx).GetVal(id)
+	if err != nil && ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	if old == 0 {
+		return false, s.insert(ctx, vector, id, meta)
+	}
+	return true, s.replace(ctx, vector, id, meta, old)
+}
+
+func Update(vector []float64, id, meta []byte) error {
+	return Get().Update(vector, id, meta)
+}
+
+// Update replaces the vector behind id. It returns ErrIDNotFound if id does not exist.
+// Empty meta keeps the metadata stored before.
+func (s *Service) Update(vector []float64, id, meta []byte) error {
+	return s.UpdateContext(context.Background(), vector, id, meta)
+}
+
+func UpdateContext(ctx context.Context, vector []float64, id, meta []byte) error {
+	return Get().UpdateContext(ctx, vector, id, meta)
+}
+
+// UpdateContext is Update which stops with the error of ctx once ctx is done.
+// A write stopped in the middle is rolled back.
+func (s *Service) UpdateContext(ctx context.Context, vector []float64, id, meta []byte) error {
+	s, leave := s.enter()
+	defer leave()
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+	old, err := s.dbContext(ctx).GetVal(id)
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if old == 0 {
+		return ErrIDNotFound
+	}
+	return s.replace(ctx, vector, id, meta, old)
+}
+
+// replace inserts the new vector, remaps id to it and then removes the old one.
+// A failure is reconciled the same way as a crash, see reconcile.
+func (s *Service) replace(ctx context.Context, vector []float64, id, meta []byte, old uint) error {
+	vector, err := s.prepare(vector)
 	if err != nil {
 		return err
 	}
+	db := s.dbContext(ctx)
+	oldMeta, err := db.GetMeta(id)
+	if err != nil {
+		return err
+	}
+	if len(meta) == 0 {
+		meta = oldMeta
+	}
+	in, err := s.ngtInsert(vector)
+	if err != nil {
+		return err
+	}
+
+	it := &intent{Op: opReplace, ID: id, Old: old, New: in, Meta: meta, OldMeta: oldMeta}
+	if err := s.journal.begin(it); err != nil {
+		s.ngtRemove(in)
+		return err
+	}
+	if err := db.Delete(id); err != nil {
+		return s.abort(it, err)
+	}
+	if err := s.setObject(db, id, in, meta); err != nil {
+		return s.abort(it, err)
+	}
+	if err := s.ngtRemove(old); err != nil {
+		return s.abort(it, err)
+	}
+	return s.journal.commit()
+}
 
-	if err := gongt.StrictRemove(in); err != nil {
+func Remove(id []byte) error {
+	return Get().Remove(id)
+}
+
+func (s *Service) Remove(id []byte) error {
+	return s.RemoveContext(context.Background(), id)
+}
+
+func RemoveContext(ctx context.Context, id []byte) error {
+	return Get().RemoveContext(ctx, id)
+}
+
+// RemoveContext is Remove which stops with the error of ctx once ctx is done.
+// A write stopped in the middle is rolled back.
+func (s *Service) RemoveContext(ctx context.Context, id []byte) error {
+	s, leave := s.enter()
+	defer leave()
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+	db := s.dbContext(ctx)
+	in, err := db.GetVal(id)
+	if err == kvs.ErrNotFound {
+		return ErrIDNotFound
+	} else if err != nil {
 		return err
 	}
+	if in == 0 {
+		return ErrIDNotFound
+	}
 
-	return s.db.Delete(id)
+	it := &intent{Op: opRemove, ID: id, Old: in}
+	if err := s.journal.begin(it); err != nil {
+		return err
+	}
+	if err := db.Delete(id); err != nil {
+		return s.abort(it, err)
+	}
+	if err := s.ngtRemove(in); err != nil {
+		return s.abort(it, err)
+	}
+	return s.journal.commit()
 }
 
 func GetObject(id []byte) (*GetObjectResult, error) {
-	return s.GetObject(id)
+	return Get().GetObject(id)
 }
 
 func (s *Service) GetObject(id []byte) (*GetObjectResult, error) {
-	in, err := s.db.GetVal(id)
+	return s.GetObjectContext(context.Background(), id)
+}
+
+func GetObjectContext(ctx context.Context, id []byte) (*GetObjectResult, error) {
+	return Get().GetObjectContext(ctx, id)
+}
+
+// GetObjectContext is GetObject which stops with the error of ctx once ctx is done.
+func (s *Service) GetObjectContext(ctx context.Context, id []byte) (*GetObjectResult, error) {
+	s, leave := s.enter()
+	defer leave()
+	db := s.dbContext(ctx)
+	in, err := db.GetVal(id)
 	if err != nil {
 		return nil, err
 	}
 	if in <= 0 {
 		return nil, fmt.Errorf("Id(%s) is not in DB error", id)
 	}
-	vector, err := gongt.GetStrictVector(in)
+	vector, err := s.ngtVector(in)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := db.GetMeta(id)
 	if err != nil {
 		return nil, err
 	}
 	ret := &GetObjectResult{
 		Id:     id,
 		Vector: vector,
+		Meta:   meta,
 	}
 	return ret, nil
 }
+
+func CreateIndex(poolSize int) error {
+	return Get().CreateIndex(poolSize)
+}
+
+// CreateIndex builds the graph for inserted objects. Searches and writes wait until it finishes.
+func (s *Service) CreateIndex(poolSize int) error {
+	s, leave := s.enter()
+	defer leave()
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+	s.imu.Lock()
+	defer s.imu.Unlock()
+	start := time.Now()
+	if err := s.ngt.CreateIndex(poolSize); err != nil {
+		return err
+	}
+	s.stats.indexCreated(start)
+	return nil
+}
+
+func SaveIndex() error {
+	return Get().SaveIndex()
+}
+
+// SaveIndex stores the index to its index path and empties the WAL. Searches and writes wait until it finishes.
+func (s *Service) SaveIndex() error {
+	s, leave := s.enter()
+	defer leave()
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+	return s.saveIndex()
+}
+
+// saveIndex is SaveIndex for the caller holding wmu.
+func (s *Service) saveIndex() error {
+	s.imu.Lock()
+	defer s.imu.Unlock()
+	start := time.Now()
+	if err := s.ngt.SaveIndex(); err != nil {
+		s.stats.saveFailed(err)
+		return err
+	}
+	if s.wal != nil {
+		if err := s.wal.Truncate(); err != nil {
+			s.stats.saveFailed(err)
+			return err
+		}
+	}
+	s.stats.indexSaved(start)
+	return nil
+}
+
+func GetDim() int {
+	return Get().GetDim()
+}
+
+// GetDim returns the dimension of the index.
+func (s *Service) GetDim() int {
+	s, leave := s.enter()
+	defer leave()
+	return s.ngtDim()
+}
+
+func GetErrors() []error {
+	return Get().GetErrors()
+}
+
+// GetErrors returns errors recorded by the index.
+func (s *Service) GetErrors() []error {
+	s, leave := s.enter()
+	defer leave()
+	s.imu.RLock()
+	defer s.imu.RUnlock()
+	return s.ngt.GetErrors()
+}
+
+func Close() error {
+	return Get().Close()
+}
+
+// Close stops the background CreateIndex and SaveIndex and closes the index, the KVS, the journal and the WAL.
+func (s *Service) Close() error {
+	s.StopAutoIndex()
+	s.StopAutoSave()
+	s.journal.close()
+	if s.wal != nil {
+		s.wal.Close()
+	}
+	s.imu.Lock()
+	s.ngt.Close()
+	s.imu.Unlock()
+	if s.db == nil {
+		return nil
+	}
+	return s.db.Close()
+}
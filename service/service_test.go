@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -116,4 +117,134 @@ func TestService(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("TestInsertWithMeta", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		id, meta := []byte("g"), []byte(`{"title":"g"}`)
+		if err := InsertWithMeta([]float64{1, 1, 0, 0, 0, 0}, id, meta); err != nil {
+			t.Fatalf("Unexpected error: TestInsertWithMeta(%v)", err)
+		}
+		if err := CreateIndex(1); err != nil {
+			t.Fatalf("Unexpected error: TestInsertWithMeta(%v)", err)
+		}
+
+		res, err := SearchByID(id, 1, gongt.DefaultEpsilon, WithMeta(true))
+		if err != nil {
+			t.Errorf("Unexpected error: TestInsertWithMeta(%v)", err)
+		}
+		if len(res) != 1 || !reflect.DeepEqual(res[0].Meta, meta) {
+			t.Errorf("TestInsertWithMeta: %v, wanted: %s", res, meta)
+		}
+		res, err = SearchByID(id, 1, gongt.DefaultEpsilon)
+		if err != nil || len(res) != 1 || res[0].Meta != nil {
+			t.Errorf("TestInsertWithMeta: %v, %v, wanted: result without meta", res, err)
+		}
+
+		obj, err := GetObject(id)
+		if err != nil {
+			t.Errorf("Unexpected error: TestInsertWithMeta(%v)", err)
+		}
+		if !reflect.DeepEqual(obj.Meta, meta) {
+			t.Errorf("TestInsertWithMeta: %s, wanted: %s", obj.Meta, meta)
+		}
+	})
+
+	t.Run("TestUpsert", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		id, meta := []byte("a"), []byte(`{"title":"a"}`)
+		if err := InsertWithMeta([]float64{1, 1, 1, 0, 0, 0}, []byte("g"), nil); err != nil {
+			t.Fatalf("Unexpected error: TestUpsert(%v)", err)
+		}
+		tests := []struct {
+			vector   []float64
+			id       []byte
+			meta     []byte
+			replaced bool
+		}{
+			{[]float64{0, 1, 1, 0, 0, 0}, id, meta, true},
+			{[]float64{0, 0, 1, 1, 0, 0}, id, nil, true},
+			{[]float64{0, 0, 0, 1, 1, 0}, []byte("h"), nil, false},
+		}
+		for _, tt := range tests {
+			replaced, err := Upsert(tt.vector, tt.id, tt.meta)
+			if err != nil {
+				t.Errorf("Unexpected error: TestUpsert(%v)", err)
+			}
+			if replaced != tt.replaced {
+				t.Errorf("TestUpsert(%s): %v, wanted: %v", tt.id, replaced, tt.replaced)
+			}
+			obj, err := GetObject(tt.id)
+			if err != nil {
+				t.Errorf("Unexpected error: TestUpsert(%v)", err)
+				continue
+			}
+			for i, v := range obj.Vector {
+				if float64(v) != tt.vector[i] {
+					t.Errorf("TestUpsert(%s): %v, wanted: %v", tt.id, obj.Vector, tt.vector)
+					break
+				}
+			}
+		}
+		obj, err := GetObject(id)
+		if err != nil || !reflect.DeepEqual(obj.Meta, meta) {
+			t.Errorf("TestUpsert: %v, %v, wanted meta: %s", obj, err, meta)
+		}
+		if err := CreateIndex(1); err != nil {
+			t.Fatalf("Unexpected error: TestUpsert(%v)", err)
+		}
+		res, err := Search([]float64{1, 0, 0, 0, 0, 0}, 1, gongt.DefaultEpsilon)
+		if err != nil || len(res) != 1 || reflect.DeepEqual(res[0].Id, id) {
+			t.Errorf("TestUpsert: %v, %v, wanted: old vector of a is removed", res, err)
+		}
+	})
+
+	t.Run("TestUpdate", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		if err := Update([]float64{0, 1, 1, 0, 0, 0}, []byte("a"), nil); err != nil {
+			t.Errorf("Unexpected error: TestUpdate(%v)", err)
+		}
+		if err := Update([]float64{0, 1, 1, 0, 0, 0}, []byte("g"), nil); err != ErrIDNotFound {
+			t.Errorf("TestUpdate: %v, wanted: %v", err, ErrIDNotFound)
+		}
+		if _, err := GetObject([]byte("g")); err == nil {
+			t.Errorf("TestUpdate: g is inserted, wanted: not found")
+		}
+	})
+}
+
+func TestContext(t *testing.T) {
+	gongt.Get().SetObjectType(gongt.Float)
+	defer SetupWithTeardown(t)()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := SearchContext(ctx, []float64{1, 0, 0, 0, 0, 0}, 1, gongt.DefaultEpsilon); err != context.Canceled {
+		t.Errorf("TestContext(Search): %v, wanted: %v", err, context.Canceled)
+	}
+	if _, err := SearchByIDContext(ctx, []byte("a"), 1, gongt.DefaultEpsilon); err != context.Canceled {
+		t.Errorf("TestContext(SearchByID): %v, wanted: %v", err, context.Canceled)
+	}
+	if _, err := GetObjectContext(ctx, []byte("a")); err != context.Canceled {
+		t.Errorf("TestContext(GetObject): %v, wanted: %v", err, context.Canceled)
+	}
+	if err := InsertContext(ctx, []float64{1, 1, 0, 0, 0, 0}, []byte("g")); err != context.Canceled {
+		t.Errorf("TestContext(Insert): %v, wanted: %v", err, context.Canceled)
+	}
+	if err := RemoveContext(ctx, []byte("a")); err != context.Canceled {
+		t.Errorf("TestContext(Remove): %v, wanted: %v", err, context.Canceled)
+	}
+	if Get().hasObject(7) {
+		t.Errorf("TestContext: object 7 is left in NGT")
+	}
+	if _, err := GetObject([]byte("a")); err != nil {
+		t.Errorf("Unexpected error: TestContext(%v)", err)
+	}
+
+	deadline, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	for i, r := range MultiSearchContext(deadline, []SearchQuery{{ID: []byte("a"), Size: 1}, {ID: []byte("b"), Size: 1}}) {
+		if r.Error != context.DeadlineExceeded {
+			t.Errorf("TestContext(MultiSearch %v): %v, wanted: %v", i, r.Error, context.DeadlineExceeded)
+		}
+	}
 }
@@ -0,0 +1,465 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kpango/glg"
+	"github.com/yahoojapan/ngtd/kvs"
+)
+
+const (
+	snapshotVersion = 1
+
+	// a snapshot is a gzipped tar of the index directory, the KVS dump and the manifest written last.
+	snapshotManifest = "MANIFEST.json"
+	snapshotIndexDir = "index"
+	snapshotKVSDump  = "kvs.jsonl"
+)
+
+var (
+	ErrBrokenSnapshot = errors.New("broken snapshot")
+	// ErrRestoreTarget is returned by RestoreSnapshot if the index exists or the KVS is not empty.
+	ErrRestoreTarget = errors.New("index or KVS to restore is not empty")
+)
+
+// Manifest describes the files in a snapshot.
+type Manifest struct {
+	Version   int       `json:"version"`
+	Created   time.Time `json:"created"`
+	Dimension int       `json:"dimension"`
+	// Objects is the number of IDs in the KVS dump.
+	Objects int            `json:"objects"`
+	Files   []ManifestFile `json:"files"`
+}
+
+// ManifestFile is a file in a snapshot. Name is slash separated.
+type ManifestFile struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// kvsEntry is a line of the KVS dump.
+type kvsEntry struct {
+	ID     []byte `json:"id"`
+	Object uint   `json:"object"`
+	Meta   []byte `json:"meta,omitempty"`
+}
+
+// Snapshot is a consistent copy of the index and the KVS staged in a temporary directory.
+type Snapshot struct {
+	Manifest *Manifest
+	dir      string
+}
+
+func TakeSnapshot() (*Snapshot, error) {
+	return Get().TakeSnapshot()
+}
+
+// TakeSnapshot saves the index and copies it with a dump of the KVS while writes wait.
+// Searches go on, and writes resume before the snapshot is written out by WriteTo.
+func (s *Service) TakeSnapshot() (*Snapshot, error) {
+	s, leave := s.enter()
+	defer leave()
+	dir, err := ioutil.TempDir("", "ngtd-snapshot")
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.stage(dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	return &Snapshot{Manifest: m, dir: dir}, nil
+}
+
+func (s *Service) stage(dir string) (*Manifest, error) {
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+	if err := s.saveIndex(); err != nil {
+		return nil, err
+	}
+	index := s.ngtPath()
+	// the WAL is empty after the save
+	err := filepath.Walk(index, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || p == WALPath(index) {
+			return err
+		}
+		rel, err := filepath.Rel(index, p)
+		if err != nil {
+			return err
+		}
+		return copyFile(p, filepath.Join(dir, snapshotIndexDir, rel))
+	})
+	if err != nil {
+		return nil, err
+	}
+	n, err := s.dumpKVS(filepath.Join(dir, snapshotKVSDump))
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manifest{
+		Version:   snapshotVersion,
+		Created:   time.Now().UTC(),
+		Dimension: s.ngtDim(),
+		Objects:   n,
+	}
+	err = filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		sum, err := sha256File(p)
+		if err != nil {
+			return err
+		}
+		m.Files = append(m.Files, ManifestFile{Name: filepath.ToSlash(rel), Size: info.Size(), SHA256: sum})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(m.Files, func(i, j int) bool {
+		return m.Files[i].Name < m.Files[j].Name
+	})
+	return m, nil
+}
+
+// dumpKVS writes every ID with its object and meta to p, and returns the number of them.
+func (s *Service) dumpKVS(p string) (int, error) {
+	f, err := os.Create(p)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	n := 0
+	rerr := s.db.Range(func(key []byte, in uint) bool {
+		var meta []byte
+		meta, err = s.db.GetMeta(key)
+		if err != nil {
+			return false
+		}
+		if err = enc.Encode(kvsEntry{ID: key, Object: in, Meta: meta}); err != nil {
+			return false
+		}
+		n++
+		return true
+	})
+	if rerr != nil {
+		return 0, rerr
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := w.Flush(); err != nil {
+		return 0, err
+	}
+	return n, f.Sync()
+}
+
+// WriteTo writes the snapshot to w as a gzipped tar.
+func (sn *Snapshot) WriteTo(w io.Writer) (int64, error) {
+	cw := &countWriter{w: w}
+	gw := gzip.NewWriter(cw)
+	tw := tar.NewWriter(gw)
+	for _, mf := range sn.Manifest.Files {
+		if err := writeTarFile(tw, filepath.Join(sn.dir, filepath.FromSlash(mf.Name)), mf.Name, sn.Manifest.Created); err != nil {
+			return cw.n, err
+		}
+	}
+	b, err := json.MarshalIndent(sn.Manifest, "", "  ")
+	if err != nil {
+		return cw.n, err
+	}
+	hdr := &tar.Header{Typeflag: tar.TypeReg, Name: snapshotManifest, Mode: 0644, Size: int64(len(b)), ModTime: sn.Manifest.Created}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return cw.n, err
+	}
+	if _, err := tw.Write(b); err != nil {
+		return cw.n, err
+	}
+	if err := tw.Close(); err != nil {
+		return cw.n, err
+	}
+	err = gw.Close()
+	return cw.n, err
+}
+
+// Close removes the staged files.
+func (sn *Snapshot) Close() error {
+	return os.RemoveAll(sn.dir)
+}
+
+func writeTarFile(tw *tar.Writer, p, name string, modTime time.Time) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	hdr := &tar.Header{Typeflag: tar.TypeReg, Name: name, Mode: 0644, Size: info.Size(), ModTime: modTime}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+// RestoreSnapshot validates the snapshot read from r against its manifest, loads the KVS dump into db and
+// moves the index to index. index must not exist or be empty, and db must be empty. db is emptied again on failure.
+func RestoreSnapshot(r io.Reader, index string, db kvs.KVS) (*Manifest, error) {
+	index = filepath.Clean(index)
+	if files, err := ioutil.ReadDir(index); err == nil && len(files) > 0 {
+		return nil, ErrRestoreTarget
+	}
+	if n, err := db.Count(); err != nil {
+		return nil, err
+	} else if n > 0 {
+		return nil, ErrRestoreTarget
+	}
+
+	// extracted next to index so that it is moved by a rename
+	if err := os.MkdirAll(filepath.Dir(index), 0755); err != nil {
+		return nil, err
+	}
+	dir, err := ioutil.TempDir(filepath.Dir(index), ".ngtd-restore")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	m, err := extract(r, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := loadKVS(filepath.Join(dir, snapshotKVSDump), db)
+	if err == nil && n != m.Objects {
+		err = fmt.Errorf("%v: %d IDs in the KVS dump, %d in the manifest", ErrBrokenSnapshot, n, m.Objects)
+	}
+	if err == nil {
+		os.Remove(index)
+		err = os.Rename(filepath.Join(dir, snapshotIndexDir), index)
+	}
+	if err != nil {
+		// db is emptied again, so that the restore can be retried
+		if cerr := clearKVS(db); cerr != nil {
+			glg.Errorf("clear the KVS of the failed restore: %v", cerr)
+		}
+		return nil, err
+	}
+	return m, nil
+}
+
+// extract unpacks the snapshot from r into dir, and returns the manifest once every file matches it.
+func extract(r io.Reader, dir string) (*Manifest, error) {
+	broken := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%v: %s", ErrBrokenSnapshot, fmt.Sprintf(format, args...))
+	}
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, broken("%v", err)
+	}
+	tr := tar.NewReader(gr)
+	var m *Manifest
+	files := make(map[string]ManifestFile)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, broken("%v", err)
+		}
+		name := path.Clean(hdr.Name)
+		if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+			return nil, broken("%s is not a regular file", hdr.Name)
+		}
+		if name == snapshotManifest {
+			m = new(Manifest)
+			if err := json.NewDecoder(tr).Decode(m); err != nil {
+				return nil, broken("manifest: %v", err)
+			}
+			continue
+		}
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return nil, broken("%s is out of the snapshot", hdr.Name)
+		}
+		mf, err := extractFile(tr, filepath.Join(dir, filepath.FromSlash(name)))
+		if _, ok := err.(*os.PathError); ok {
+			return nil, err
+		} else if err != nil {
+			// the archive ends or is corrupted in the middle of the file
+			return nil, broken("%s: %v", hdr.Name, err)
+		}
+		mf.Name = name
+		files[name] = mf
+	}
+
+	if m == nil {
+		return nil, broken("no manifest")
+	}
+	if m.Version != snapshotVersion {
+		return nil, broken("unsupported version %d", m.Version)
+	}
+	if len(files) != len(m.Files) {
+		return nil, broken("%d files, %d in the manifest", len(files), len(m.Files))
+	}
+	for _, want := range m.Files {
+		if got, ok := files[want.Name]; !ok || got != want {
+			return nil, broken("%s does not match the manifest", want.Name)
+		}
+	}
+	if _, ok := files[snapshotKVSDump]; !ok {
+		return nil, broken("no KVS dump")
+	}
+	return m, nil
+}
+
+func extractFile(r io.Reader, p string) (ManifestFile, error) {
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return ManifestFile{}, err
+	}
+	f, err := os.Create(p)
+	if err != nil {
+		return ManifestFile{}, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(f, h), r)
+	if err != nil {
+		return ManifestFile{}, err
+	}
+	if err := f.Sync(); err != nil {
+		return ManifestFile{}, err
+	}
+	return ManifestFile{Size: n, SHA256: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+// loadKVS sets every ID of the dump at p to db, and returns the number of them.
+func loadKVS(p string, db kvs.KVS) (int, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	dec := json.NewDecoder(bufio.NewReader(f))
+	n := 0
+	for {
+		var e kvsEntry
+		if err := dec.Decode(&e); err == io.EOF {
+			return n, nil
+		} else if err != nil {
+			return n, fmt.Errorf("%v: KVS dump: %v", ErrBrokenSnapshot, err)
+		}
+		if err := db.Set(e.ID, e.Object); err != nil {
+			return n, err
+		}
+		if len(e.Meta) != 0 {
+			if err := db.SetMeta(e.ID, e.Meta); err != nil {
+				return n, err
+			}
+		}
+		n++
+	}
+}
+
+// clearKVS deletes every ID in db.
+func clearKVS(db kvs.KVS) error {
+	var keys [][]byte
+	if err := db.Range(func(key []byte, val uint) bool {
+		keys = append(keys, key)
+		return true
+	}); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := db.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func sha256File(p string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countWriter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	return n, err
+}
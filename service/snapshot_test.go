@@ -0,0 +1,169 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/yahoojapan/gongt"
+	"github.com/yahoojapan/ngtd/ngtdtest"
+)
+
+// rewrite returns the snapshot with f applied to the content of each file.
+func rewrite(t *testing.T, b []byte, f func(name string, content []byte) []byte) []byte {
+	gr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("Unexpected error: rewrite(%v)", err)
+	}
+	tr := tar.NewReader(gr)
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: rewrite(%v)", err)
+		}
+		content, _ := ioutil.ReadAll(tr)
+		content = f(hdr.Name, content)
+		hdr.Size = int64(len(content))
+		tw.WriteHeader(hdr)
+		tw.Write(content)
+	}
+	tw.Close()
+	gw.Close()
+	return buf.Bytes()
+}
+
+func TestSnapshot(t *testing.T) {
+	gongt.Get().SetObjectType(gongt.Float)
+	defer SetupWithTeardown(t)()
+	defer SetupWithWAL(t)()
+	if err := InsertWithMeta([]float64{1, 1, 0, 0, 0, 0}, []byte("g"), []byte("meta")); err != nil {
+		t.Fatalf("Unexpected error: TestSnapshot(%v)", err)
+	}
+
+	sn, err := TakeSnapshot()
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSnapshot(%v)", err)
+	}
+	defer sn.Close()
+	buf := &bytes.Buffer{}
+	if _, err := sn.WriteTo(buf); err != nil {
+		t.Fatalf("Unexpected error: TestSnapshot(%v)", err)
+	}
+	if m := sn.Manifest; m.Objects != 7 || m.Dimension != 6 || len(m.Files) < 2 {
+		t.Errorf("TestSnapshot: %+v, wanted: 7 objects of dimension 6", m)
+	}
+	if st, _ := GetStats(); st.UnsavedObjects != 0 {
+		t.Errorf("TestSnapshot: %d unsaved objects, wanted: saved", st.UnsavedObjects)
+	}
+
+	dir, err := ioutil.TempDir("", "ngtd-restore")
+	if err != nil {
+		t.Fatalf("Unexpected error: TestSnapshot(%v)", err)
+	}
+	defer os.RemoveAll(dir)
+
+	t.Run("TestRestore", func(t *testing.T) {
+		index := filepath.Join(dir, "index")
+		db := ngtdtest.NewMap()
+		m, err := RestoreSnapshot(bytes.NewReader(buf.Bytes()), index, db)
+		if err != nil {
+			t.Fatalf("Unexpected error: TestRestore(%v)", err)
+		}
+		if !reflect.DeepEqual(m, sn.Manifest) {
+			t.Errorf("TestRestore: %+v, wanted: %+v", m, sn.Manifest)
+		}
+
+		srv := NewServiceWithNGT(gongt.New(index).Open(), db)
+		defer srv.ngt.Close()
+		for id, want := range map[string][]float32{
+			"a": {1, 0, 0, 0, 0, 0},
+			"g": {1, 1, 0, 0, 0, 0},
+		} {
+			obj, err := srv.GetObject([]byte(id))
+			if err != nil || !reflect.DeepEqual(obj.Vector, want) {
+				t.Errorf("TestRestore(%v): %v, %v, wanted: %v", id, obj, err, want)
+			}
+		}
+		if obj, _ := srv.GetObject([]byte("g")); obj == nil || string(obj.Meta) != "meta" {
+			t.Errorf("TestRestore(g): %v, wanted: meta", obj)
+		}
+
+		if _, err := RestoreSnapshot(bytes.NewReader(buf.Bytes()), index, ngtdtest.NewMap()); err != ErrRestoreTarget {
+			t.Errorf("TestRestore: %v to the restored index, wanted: %v", err, ErrRestoreTarget)
+		}
+		if _, err := RestoreSnapshot(bytes.NewReader(buf.Bytes()), filepath.Join(dir, "other"), db); err != ErrRestoreTarget {
+			t.Errorf("TestRestore: %v to the restored KVS, wanted: %v", err, ErrRestoreTarget)
+		}
+	})
+
+	t.Run("TestBroken", func(t *testing.T) {
+		tests := []struct {
+			name string
+			b    []byte
+		}{
+			{"truncated", buf.Bytes()[:buf.Len()/2]},
+			{"tampered", rewrite(t, buf.Bytes(), func(name string, content []byte) []byte {
+				if name == snapshotKVSDump {
+					return bytes.Replace(content, []byte(`"object":1`), []byte(`"object":2`), 1)
+				}
+				return content
+			})},
+			{"no manifest", rewrite(t, buf.Bytes(), func(name string, content []byte) []byte {
+				if name == snapshotManifest {
+					return []byte("{}")
+				}
+				return content
+			})},
+			{"objects mismatch", rewrite(t, buf.Bytes(), func(name string, content []byte) []byte {
+				if name == snapshotManifest {
+					return bytes.Replace(content, []byte(`"objects": 7`), []byte(`"objects": 8`), 1)
+				}
+				return content
+			})},
+		}
+		for i, tt := range tests {
+			index := filepath.Join(dir, "broken", strconv.Itoa(i))
+			db := ngtdtest.NewMap()
+			_, err := RestoreSnapshot(bytes.NewReader(tt.b), index, db)
+			if err == nil || !strings.HasPrefix(err.Error(), ErrBrokenSnapshot.Error()) {
+				t.Errorf("TestBroken(%v): %v, wanted: %v", tt.name, err, ErrBrokenSnapshot)
+			}
+			if _, err := os.Stat(index); !os.IsNotExist(err) {
+				t.Errorf("TestBroken(%v): index is restored", tt.name)
+			}
+			if n, _ := db.Count(); n != 0 {
+				t.Errorf("TestBroken(%v): %d IDs are left in the KVS, wanted: empty", tt.name, n)
+			}
+		}
+	})
+}
@@ -0,0 +1,248 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/kpango/glg"
+	"github.com/yahoojapan/ngtd/kvs"
+)
+
+var (
+	started = time.Now()
+)
+
+// Stats reports the state of the index and the KVS.
+type Stats struct {
+	// Objects is the number of IDs in the KVS.
+	Objects int
+	// NGTObjects is the number of objects in NGT and IndexedObjects is the number of them in the graph.
+	// gongt does not expose them, so they start from the KVS count when it is set and
+	// are kept up to date by the writes through the service.
+	NGTObjects       int
+	IndexedObjects   int
+	UnindexedObjects int
+	// UnsavedObjects is the number of objects inserted, removed or indexed since the last SaveIndex.
+	UnsavedObjects int
+
+	LastCreateIndex     time.Time
+	CreateIndexDuration time.Duration
+	LastSaveIndex       time.Time
+	SaveIndexDuration   time.Duration
+
+	// IndexSize is the total size of the files in the index directory in bytes.
+	IndexSize int64
+	KVSType   string
+	Uptime    time.Duration
+	// AutoIndex is the status of the background CreateIndex, nil if it is disabled.
+	AutoIndex *AutoIndexStatus
+	// AutoSave is the status of the background SaveIndex, nil if it is disabled.
+	AutoSave *AutoSaveStatus
+}
+
+// objectStats counts NGT objects, and remembers the ones inserted since the last CreateIndex
+// and the ones changed since the last SaveIndex.
+type objectStats struct {
+	mu        sync.Mutex
+	objects   int
+	indexed   int
+	unindexed map[uint]struct{}
+	unsaved   map[uint]struct{}
+
+	lastCreateIndex     time.Time
+	createIndexDuration time.Duration
+	lastSaveIndex       time.Time
+	saveIndexDuration   time.Duration
+	// saveError is the error of the last SaveIndex, nil if it succeeded.
+	saveError error
+}
+
+func newObjectStats() *objectStats {
+	return &objectStats{
+		unindexed: make(map[uint]struct{}),
+		unsaved:   make(map[uint]struct{}),
+	}
+}
+
+// reset assumes n objects which are all indexed and saved, as an index is opened.
+func (o *objectStats) reset(n int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.objects, o.indexed = n, n
+	o.unindexed = make(map[uint]struct{})
+	o.unsaved = make(map[uint]struct{})
+}
+
+// inserted counts the object and returns the numbers of objects waiting for CreateIndex and for SaveIndex.
+func (o *objectStats) inserted(in uint) (pending, unsaved int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.objects++
+	o.unindexed[in] = struct{}{}
+	o.unsaved[in] = struct{}{}
+	return len(o.unindexed), len(o.unsaved)
+}
+
+func (o *objectStats) pending() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.unindexed)
+}
+
+func (o *objectStats) unsavedObjects() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.unsaved)
+}
+
+// removed uncounts the object and returns the number of objects waiting for SaveIndex.
+func (o *objectStats) removed(in uint) int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.objects--
+	if _, ok := o.unindexed[in]; ok {
+		delete(o.unindexed, in)
+	} else {
+		o.indexed--
+	}
+	o.unsaved[in] = struct{}{}
+	return len(o.unsaved)
+}
+
+func (o *objectStats) indexCreated(start time.Time) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.indexed += len(o.unindexed)
+	for in := range o.unindexed {
+		o.unsaved[in] = struct{}{}
+	}
+	o.unindexed = make(map[uint]struct{})
+	o.lastCreateIndex = start
+	o.createIndexDuration = time.Since(start)
+}
+
+func (o *objectStats) indexSaved(start time.Time) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.lastSaveIndex = start
+	o.saveIndexDuration = time.Since(start)
+	o.unsaved = make(map[uint]struct{})
+	o.saveError = nil
+}
+
+// changed marks the objects as waiting for SaveIndex.
+func (o *objectStats) changed(ins []uint) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, in := range ins {
+		o.unsaved[in] = struct{}{}
+	}
+}
+
+func (o *objectStats) saveFailed(err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.saveError = err
+}
+
+// resetObjectStats counts the objects of the newly set KVS.
+func (s *Service) resetObjectStats() {
+	if s.db == nil {
+		return
+	}
+	n, err := s.db.Count()
+	if err != nil {
+		glg.Warnf("cannot count objects: %v", err)
+	}
+	s.stats.reset(n)
+}
+
+func GetStats() (*Stats, error) {
+	return Get().GetStats()
+}
+
+// GetStats returns the current Stats.
+func (s *Service) GetStats() (*Stats, error) {
+	s, leave := s.enter()
+	defer leave()
+	n, err := s.db.Count()
+	if err != nil {
+		return nil, err
+	}
+	// SaveIndex rewrites the directory under the exclusive lock
+	s.imu.RLock()
+	size, err := dirSize(s.ngt.GetPath())
+	s.imu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	o := s.stats
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return &Stats{
+		Objects:             n,
+		NGTObjects:          o.objects,
+		IndexedObjects:      o.indexed,
+		UnindexedObjects:    len(o.unindexed),
+		UnsavedObjects:      len(o.unsaved),
+		LastCreateIndex:     o.lastCreateIndex,
+		CreateIndexDuration: o.createIndexDuration,
+		LastSaveIndex:       o.lastSaveIndex,
+		SaveIndexDuration:   o.saveIndexDuration,
+		IndexSize:           size,
+		KVSType:             kvsType(s.db),
+		Uptime:              time.Since(started),
+		AutoIndex:           s.AutoIndexStatus(),
+		AutoSave:            s.AutoSaveStatus(),
+	}, nil
+}
+
+// dirSize returns the total size of the files under dir. It is 0 if dir does not exist yet.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+func kvsType(db kvs.KVS) string {
+	switch db.(type) {
+	case *kvs.BoltDB:
+		return "bolt"
+	case *kvs.GoLevel:
+		return "golevel"
+	case *kvs.Redis:
+		return "redis"
+	}
+	return fmt.Sprintf("%T", db)
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yahoojapan/gongt"
+)
+
+func TestStats(t *testing.T) {
+	gongt.Get().SetObjectType(gongt.Float)
+	defer SetupWithTeardown(t)()
+
+	st, err := GetStats()
+	if err != nil {
+		t.Fatalf("Unexpected error: TestStats(%v)", err)
+	}
+	if st.Objects != 6 || st.NGTObjects != 6 || st.IndexedObjects != 6 || st.UnindexedObjects != 0 {
+		t.Errorf("TestStats: %+v, wanted: 6 objects, all indexed", st)
+	}
+	if st.KVSType == "" || st.Uptime <= 0 {
+		t.Errorf("TestStats: %+v, wanted: KVS type and uptime", st)
+	}
+
+	if err := Insert([]float64{1, 1, 0, 0, 0, 0}, []byte("g")); err != nil {
+		t.Fatalf("Unexpected error: TestStats(%v)", err)
+	}
+	if err := Remove([]byte("a")); err != nil {
+		t.Fatalf("Unexpected error: TestStats(%v)", err)
+	}
+	st, _ = GetStats()
+	if st.Objects != 6 || st.NGTObjects != 6 || st.IndexedObjects != 5 || st.UnindexedObjects != 1 {
+		t.Errorf("TestStats: %+v, wanted: 6 objects, 1 unindexed", st)
+	}
+
+	before := time.Now()
+	if err := CreateIndex(1); err != nil {
+		t.Fatalf("Unexpected error: TestStats(%v)", err)
+	}
+	st, _ = GetStats()
+	if st.IndexedObjects != 6 || st.UnindexedObjects != 0 || st.LastCreateIndex.Before(before) {
+		t.Errorf("TestStats: %+v, wanted: 6 indexed objects after CreateIndex", st)
+	}
+}
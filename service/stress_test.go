@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/yahoojapan/gongt"
+)
+
+// TestStress runs every kind of operation at once. It is meant to be run with -race.
+func TestStress(t *testing.T) {
+	gongt.Get().SetObjectType(gongt.Float)
+	defer SetupWithTeardown(t)()
+
+	const (
+		workers = 4
+		rounds  = 50
+	)
+	errCh := make(chan error, 100)
+	report := func(op string, err error) {
+		if err != nil {
+			select {
+			case errCh <- fmt.Errorf("%s: %v", op, err):
+			default:
+			}
+		}
+	}
+
+	wg := &sync.WaitGroup{}
+	run := func(f func(w, i int)) {
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for i := 0; i < rounds; i++ {
+					f(w, i)
+				}
+			}(w)
+		}
+	}
+
+	run(func(w, i int) {
+		_, err := Search([]float64{1, 0, 0, 0, 0, 0}, 3, gongt.DefaultEpsilon, WithMeta(true))
+		report("Search", err)
+		_, err = SearchByID([]byte("b"), 3, gongt.DefaultEpsilon, WithRerank(5, ""))
+		report("SearchByID", err)
+		_, err = SearchRange([]float64{0, 1, 0, 0, 0, 0}, 2, gongt.DefaultEpsilon)
+		report("SearchRange", err)
+		_, err = GetObject([]byte("c"))
+		report("GetObject", err)
+	})
+	run(func(w, i int) {
+		id := []byte(fmt.Sprintf("w%d-%d", w, i))
+		v := []float64{float64(w), float64(i), 1, 0, 0, 0}
+		report("InsertWithMeta", InsertWithMeta(v, id, []byte(`{"w":1}`)))
+		_, err := Upsert([]float64{float64(w), float64(i), 2, 0, 0, 0}, id, nil)
+		report("Upsert", err)
+		report("Update", Update(v, id, nil))
+		if i%2 == 0 {
+			report("Remove", Remove(id))
+		}
+	})
+	run(func(w, i int) {
+		switch i % 5 {
+		case 0:
+			report("CreateIndex", CreateIndex(1))
+		case 1:
+			if w == 0 {
+				report("SaveIndex", SaveIndex())
+			}
+		case 2:
+			_, _, err := ListIDs(nil, []byte("w"), 10)
+			report("ListIDs", err)
+		case 3:
+			_, err := Count()
+			report("Count", err)
+		case 4:
+			_, err := GetStats()
+			report("GetStats", err)
+		}
+	})
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		t.Errorf("Unexpected error: TestStress(%v)", err)
+	}
+
+	if n, err := Count(); err != nil || n != 6+workers*rounds/2 {
+		t.Errorf("TestStress: %v objects, %v, wanted: %v", n, err, 6+workers*rounds/2)
+	}
+	err := Fsck(context.Background(), 0, func(inc Inconsistency) error {
+		t.Errorf("TestStress: %+v after the operations", inc)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: TestStress(%v)", err)
+	}
+}
@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// ReasonDimensionMismatch means the length of the vector differs from the dimension of the index.
+	ReasonDimensionMismatch = "dimension_mismatch"
+	// ReasonInvalidValue means the vector has NaN or Inf.
+	ReasonInvalidValue = "invalid_value"
+	// ReasonZeroVector means the vector cannot be normalized because its norm is 0.
+	ReasonZeroVector = "zero_vector"
+)
+
+// VectorError is returned for a vector which cannot be inserted or searched.
+type VectorError struct {
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+func (e *VectorError) Error() string {
+	return e.Reason + ": " + e.Message
+}
+
+// IsVectorError reports whether err is caused by the vector given by the client.
+func IsVectorError(err error) bool {
+	_, ok := err.(*VectorError)
+	return ok
+}
+
+func SetNormalize(normalize bool) {
+	Get().SetNormalize(normalize)
+}
+
+// SetNormalize makes the service L2-normalize vectors on insert and on query.
+func (s *Service) SetNormalize(normalize bool) {
+	s.normalize = normalize
+}
+
+// prepare validates vector against the index and normalizes it if the service is set to.
+// vector itself is never modified.
+func (s *Service) prepare(vector []float64) ([]float64, error) {
+	if dim := s.ngtDim(); len(vector) != dim {
+		return nil, &VectorError{
+			Reason:  ReasonDimensionMismatch,
+			Message: fmt.Sprintf("vector has %d elements, index dimension is %d", len(vector), dim),
+		}
+	}
+	var norm float64
+	for i, v := range vector {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, &VectorError{
+				Reason:  ReasonInvalidValue,
+				Message: fmt.Sprintf("vector[%d] is %v", i, v),
+			}
+		}
+		norm += v * v
+	}
+	if !s.normalize {
+		return vector, nil
+	}
+	if norm == 0 {
+		return nil, &VectorError{
+			Reason:  ReasonZeroVector,
+			Message: "zero vector cannot be normalized",
+		}
+	}
+	norm = math.Sqrt(norm)
+	ret := make([]float64, len(vector))
+	for i, v := range vector {
+		ret[i] = v / norm
+	}
+	return ret, nil
+}
@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/yahoojapan/gongt"
+)
+
+func TestVectorValidation(t *testing.T) {
+	defer SetupWithTeardown(t)()
+
+	tests := []struct {
+		name   string
+		vector []float64
+		reason string
+	}{
+		{"short", []float64{1, 0, 0}, ReasonDimensionMismatch},
+		{"long", []float64{1, 0, 0, 0, 0, 0, 0}, ReasonDimensionMismatch},
+		{"NaN", []float64{1, math.NaN(), 0, 0, 0, 0}, ReasonInvalidValue},
+		{"Inf", []float64{1, 0, 0, 0, 0, math.Inf(-1)}, ReasonInvalidValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Insert(tt.vector, []byte("g"))
+			if e, ok := err.(*VectorError); !ok || e.Reason != tt.reason {
+				t.Errorf("TestVectorValidation(insert): %v, wanted: %v", err, tt.reason)
+			}
+			_, err = Search(tt.vector, 1, 0)
+			if e, ok := err.(*VectorError); !ok || e.Reason != tt.reason {
+				t.Errorf("TestVectorValidation(search): %v, wanted: %v", err, tt.reason)
+			}
+		})
+	}
+	if _, err := GetObject([]byte("g")); err == nil {
+		t.Errorf("TestVectorValidation: invalid vector is inserted")
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	gongt.Get().SetObjectType(gongt.Float)
+	defer SetupWithTeardown(t)()
+	SetNormalize(true)
+	defer SetNormalize(false)
+
+	v := []float64{3, 4, 0, 0, 0, 0}
+	if err := Insert(v, []byte("g")); err != nil {
+		t.Fatalf("Unexpected error: TestNormalize(%v)", err)
+	}
+	if err := CreateIndex(1); err != nil {
+		t.Fatalf("Unexpected error: TestNormalize(%v)", err)
+	}
+	if !reflect.DeepEqual(v, []float64{3, 4, 0, 0, 0, 0}) {
+		t.Errorf("TestNormalize: input is modified to %v", v)
+	}
+	obj, err := GetObject([]byte("g"))
+	if err != nil {
+		t.Fatalf("Unexpected error: TestNormalize(%v)", err)
+	}
+	if want := []float32{0.6, 0.8, 0, 0, 0, 0}; !reflect.DeepEqual(obj.Vector, want) {
+		t.Errorf("TestNormalize: %v, wanted: %v", obj.Vector, want)
+	}
+
+	result, err := Search([]float64{30, 40, 0, 0, 0, 0}, 1, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: TestNormalize(%v)", err)
+	}
+	if len(result) != 1 || string(result[0].Id) != "g" || result[0].Distance > 1e-6 {
+		t.Errorf("TestNormalize: %v, wanted: g at 0", result)
+	}
+
+	err = Insert(make([]float64, 6), []byte("h"))
+	if e, ok := err.(*VectorError); !ok || e.Reason != ReasonZeroVector {
+		t.Errorf("TestNormalize: %v, wanted: %v", err, ReasonZeroVector)
+	}
+}
@@ -0,0 +1,290 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/kpango/glg"
+	"github.com/yahoojapan/ngtd/wal"
+)
+
+const (
+	walFile = "ngtd.wal"
+
+	walInsert byte = 1
+	walRemove byte = 2
+)
+
+var (
+	errBrokenWALRecord = errors.New("broken WAL record")
+
+	walSyncPolicy   = wal.SyncAlways
+	walSyncInterval time.Duration
+)
+
+// walRecord is an insert or a remove of an NGT object. Vector is set for inserts.
+type walRecord struct {
+	op     byte
+	in     uint
+	vector []float64
+}
+
+func (r *walRecord) encode() []byte {
+	b := make([]byte, 1+2*binary.MaxVarintLen64+8*len(r.vector))
+	b[0] = r.op
+	n := 1 + binary.PutUvarint(b[1:], uint64(r.in))
+	if r.op == walInsert {
+		n += binary.PutUvarint(b[n:], uint64(len(r.vector)))
+		for _, v := range r.vector {
+			binary.LittleEndian.PutUint64(b[n:], math.Float64bits(v))
+			n += 8
+		}
+	}
+	return b[:n]
+}
+
+func decodeWALRecord(b []byte) (*walRecord, error) {
+	if len(b) == 0 {
+		return nil, errBrokenWALRecord
+	}
+	r := &walRecord{op: b[0]}
+	in, n := binary.Uvarint(b[1:])
+	if n <= 0 {
+		return nil, errBrokenWALRecord
+	}
+	r.in = uint(in)
+	b = b[1+n:]
+	switch r.op {
+	case walRemove:
+		return r, nil
+	case walInsert:
+		dim, n := binary.Uvarint(b)
+		if n <= 0 || uint64(len(b)-n) != 8*dim {
+			return nil, errBrokenWALRecord
+		}
+		b = b[n:]
+		r.vector = make([]float64, dim)
+		for i := range r.vector {
+			r.vector[i] = math.Float64frombits(binary.LittleEndian.Uint64(b[8*i:]))
+		}
+		return r, nil
+	}
+	return nil, errBrokenWALRecord
+}
+
+// WALPath returns the path of the WAL in the index directory.
+func WALPath(index string) string {
+	return filepath.Join(index, walFile)
+}
+
+// SetWALSync sets the fsync policy of the WALs opened afterwards. interval is used by wal.SyncInterval.
+func SetWALSync(policy wal.SyncPolicy, interval time.Duration) {
+	walSyncPolicy = policy
+	walSyncInterval = interval
+}
+
+// CountWAL returns the number of records in the WAL at path, which the saved index does not have yet
+// unless the process crashed right after a save. Unlike OpenWAL, it neither replays nor changes the log.
+func CountWAL(path string) (int, error) {
+	n := 0
+	torn, err := wal.Read(path, func(b []byte) error {
+		if _, err := decodeWALRecord(b); err != nil {
+			return err
+		}
+		n++
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if torn > 0 {
+		glg.Warnf("broken %d bytes at the end of %s", torn, path)
+	}
+	return n, nil
+}
+
+func OpenWAL(path string) error {
+	return Get().OpenWAL(path)
+}
+
+// OpenWAL logs every insert and remove of NGT objects to path until SaveIndex persists them.
+// The objects logged by the previous process are replayed on the index first, and indexed again.
+// The index is opened from the last save, so the log holds every change the KVS may point to.
+// It should be called before serving, and before OpenJournal so that the journal sees the replayed index.
+func (s *Service) OpenWAL(path string) error {
+	l, err := wal.Open(path, walSyncPolicy, walSyncInterval)
+	if err != nil {
+		return err
+	}
+	if n := l.Torn(); n > 0 {
+		glg.Warnf("cut off broken %d bytes at the end of %s", n, path)
+	}
+	n, changed, err := s.replay(l)
+	if err != nil {
+		l.Close()
+		return err
+	}
+	s.wmu.Lock()
+	if s.wal != nil {
+		s.wal.Close()
+	}
+	s.wal = l
+	s.wmu.Unlock()
+	if n == 0 {
+		return nil
+	}
+	glg.Infof("replayed %d records of %s", n, path)
+	if err := s.CreateIndex(runtime.NumCPU()); err != nil {
+		return err
+	}
+	// the replayed objects are in the log until the next save
+	s.resetObjectStats()
+	s.stats.changed(changed)
+	return nil
+}
+
+// replay applies the records of l to NGT and remaps IDs in the KVS to the objects which got other IDs than
+// the logged ones. It returns the number of records and the objects inserted or removed.
+// A record the index already reflects is skipped, as the log survives a crash right after the index is saved.
+// The replayed objects are kept for OpenJournal, which rolls back those no ID points to.
+func (s *Service) replay(l *wal.Log) (int, []uint, error) {
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+	// remap holds the object each logged ID of an insert got this time,
+	// and created the objects inserted this time, which may have the logged IDs of others.
+	remap := make(map[uint]uint)
+	created := make(map[uint]bool)
+	var changed []uint
+	n := 0
+	err := l.Replay(func(b []byte) error {
+		r, err := decodeWALRecord(b)
+		if err != nil {
+			return err
+		}
+		n++
+		switch r.op {
+		case walInsert:
+			if !created[r.in] && s.hasObject(r.in) {
+				remap[r.in] = r.in
+				return nil
+			}
+			in, err := s.ngtInsert(r.vector)
+			if err != nil {
+				return err
+			}
+			remap[r.in] = in
+			created[in] = true
+			changed = append(changed, in)
+		case walRemove:
+			in, ok := remap[r.in]
+			if !ok && created[r.in] {
+				// the logged object is not in the index, and another one got its ID
+				return nil
+			}
+			if !ok {
+				in = r.in
+			}
+			delete(remap, r.in)
+			delete(created, in)
+			if !s.hasObject(in) {
+				return nil
+			}
+			if err := s.ngtRemove(in); err != nil {
+				return err
+			}
+			changed = append(changed, in)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := s.remapIDs(remap); err != nil {
+		return 0, nil, err
+	}
+	s.replayed = make(map[uint]uint, len(created)+len(remap))
+	for in := range created {
+		// the logged object of the ID is gone, unless remap says otherwise
+		s.replayed[in] = 0
+	}
+	for old, in := range remap {
+		s.replayed[old] = in
+	}
+	return n, changed, nil
+}
+
+// remapIDs points the IDs in the KVS from the logged objects to the replayed ones.
+// Every ID is looked up before any of them is changed, since the replayed objects may have the IDs of other logged ones.
+func (s *Service) remapIDs(remap map[uint]uint) error {
+	type move struct {
+		id   []byte
+		meta []byte
+		in   uint
+	}
+	var moves []move
+	for old, in := range remap {
+		if old == in {
+			continue
+		}
+		id, _ := s.db.GetKey(old)
+		if len(id) == 0 {
+			continue
+		}
+		if cur, _ := s.db.GetVal(id); cur != old {
+			continue
+		}
+		meta, err := s.db.GetMeta(id)
+		if err != nil {
+			return err
+		}
+		moves = append(moves, move{id, meta, in})
+	}
+	for _, m := range moves {
+		if err := s.db.Delete(m.id); err != nil {
+			return err
+		}
+	}
+	for _, m := range moves {
+		if err := s.setObject(s.db, m.id, m.in, m.meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logInsert appends the insert to the WAL. Writes are serialized, so the records are in the order of NGT.
+func (s *Service) logInsert(in uint, vector []float64) error {
+	if s.wal == nil {
+		return nil
+	}
+	r := &walRecord{op: walInsert, in: in, vector: vector}
+	return s.wal.Append(r.encode())
+}
+
+func (s *Service) logRemove(in uint) error {
+	if s.wal == nil {
+		return nil
+	}
+	r := &walRecord{op: walRemove, in: in}
+	return s.wal.Append(r.encode())
+}
@@ -0,0 +1,219 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/yahoojapan/gongt"
+)
+
+// SetupWithWAL opens the WAL in the index directory.
+func SetupWithWAL(t *testing.T) func() {
+	if err := OpenWAL(WALPath(gongt.Get().GetPath())); err != nil {
+		t.Fatalf("Unexpected error: SetupWithWAL(%v)", err)
+	}
+	return func() {
+		if Get().wal != nil {
+			Get().wal.Close()
+			Get().wal = nil
+		}
+	}
+}
+
+// crash reopens the index from the last save with the KVS as it is, and replays the WAL.
+// before is called between them.
+func crash(t *testing.T, before func()) {
+	Get().wal.Close()
+	Get().wal = nil
+	gongt.Get().Close()
+	gongt.Get().Open()
+	if errs := gongt.GetErrors(); len(errs) > 0 {
+		t.Fatalf("Unexpected error: crash(%v)", errs)
+	}
+	if before != nil {
+		before()
+	}
+	if err := OpenWAL(WALPath(gongt.Get().GetPath())); err != nil {
+		t.Fatalf("Unexpected error: crash(%v)", err)
+	}
+}
+
+func TestWAL(t *testing.T) {
+	gongt.Get().SetObjectType(gongt.Float)
+
+	write := func(t *testing.T) {
+		if err := Insert([]float64{1, 1, 0, 0, 0, 0}, []byte("g")); err != nil {
+			t.Fatalf("Unexpected error: write(%v)", err)
+		}
+		if err := InsertWithMeta([]float64{0, 1, 1, 0, 0, 0}, []byte("h"), []byte("meta")); err != nil {
+			t.Fatalf("Unexpected error: write(%v)", err)
+		}
+		if err := Remove([]byte("a")); err != nil {
+			t.Fatalf("Unexpected error: write(%v)", err)
+		}
+		if err := Update([]float64{0, 0, 0, 1, 1, 0}, []byte("b"), nil); err != nil {
+			t.Fatalf("Unexpected error: write(%v)", err)
+		}
+	}
+	verify := func(t *testing.T) {
+		for id, want := range map[string][]float32{
+			"b": {0, 0, 0, 1, 1, 0},
+			"c": {0, 0, 1, 0, 0, 0},
+			"g": {1, 1, 0, 0, 0, 0},
+			"h": {0, 1, 1, 0, 0, 0},
+		} {
+			obj, err := GetObject([]byte(id))
+			if err != nil || !reflect.DeepEqual(obj.Vector, want) {
+				t.Errorf("verify(%v): %v, %v, wanted: %v", id, obj, err, want)
+			}
+		}
+		if obj, _ := GetObject([]byte("h")); obj == nil || string(obj.Meta) != "meta" {
+			t.Errorf("verify(h): %v, wanted: meta", obj)
+		}
+		if _, err := GetObject([]byte("a")); err == nil {
+			t.Errorf("verify(a): nil, wanted: not found")
+		}
+		if res, err := Search([]float64{1, 1, 0, 0, 0, 0}, 1, 0); err != nil || len(res) != 1 || string(res[0].Id) != "g" {
+			t.Errorf("verify: %v, %v, wanted: g", res, err)
+		}
+		err := Fsck(context.Background(), 0, func(inc Inconsistency) error {
+			t.Errorf("verify: %+v, wanted: consistent", inc)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: verify(%v)", err)
+		}
+	}
+
+	t.Run("TestReplay", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		defer SetupWithWAL(t)()
+
+		write(t)
+		crash(t, nil)
+		verify(t)
+		if st, _ := GetStats(); st.Objects != 7 || st.NGTObjects != 7 || st.UnindexedObjects != 0 || st.UnsavedObjects == 0 {
+			t.Errorf("TestReplay: %+v, wanted: 7 objects, indexed and unsaved", st)
+		}
+
+		// the replayed objects stay in the WAL until the next save
+		crash(t, nil)
+		verify(t)
+		if err := SaveIndex(); err != nil {
+			t.Fatalf("Unexpected error: TestReplay(%v)", err)
+		}
+		if n := Get().wal.Size(); n != 0 {
+			t.Errorf("TestReplay: WAL of %d bytes after save, wanted: empty", n)
+		}
+		crash(t, nil)
+		verify(t)
+	})
+
+	t.Run("TestCountWAL", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		defer SetupWithWAL(t)()
+
+		path := WALPath(gongt.Get().GetPath())
+		write(t)
+		// the inserts, the remove, and the remove and the insert of the update
+		if n, err := CountWAL(path); err != nil || n != 5 {
+			t.Errorf("TestCountWAL: %v, %v, wanted: 5 records", n, err)
+		}
+		if err := SaveIndex(); err != nil {
+			t.Fatalf("Unexpected error: TestCountWAL(%v)", err)
+		}
+		if n, err := CountWAL(path); err != nil || n != 0 {
+			t.Errorf("TestCountWAL: %v, %v after save, wanted: 0 records", n, err)
+		}
+	})
+
+	t.Run("TestRemap", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		defer SetupWithWAL(t)()
+
+		// the object is neither saved nor logged, so every replayed object gets another ID than the logged one
+		in, _ := gongt.StrictInsert([]float64{0, 0, 0, 0, 0, 1})
+		gongt.StrictRemove(in)
+		write(t)
+		crash(t, nil)
+		verify(t)
+	})
+
+	t.Run("TestLoggedBeforeJournal", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		defer SetupWithWAL(t)()
+
+		write(t)
+		// the insert is logged, but the process crashes before the journal records it
+		if _, err := Get().ngtInsert([]float64{0, 0, 0, 0, 0, 1}); err != nil {
+			t.Fatalf("Unexpected error: TestLoggedBeforeJournal(%v)", err)
+		}
+		crash(t, nil)
+		defer SetupWithJournal(t, nil)()
+		verify(t)
+		if st, _ := GetStats(); st.NGTObjects != 7 {
+			t.Errorf("TestLoggedBeforeJournal: %+v, wanted: 7 objects in NGT", st)
+		}
+	})
+
+	t.Run("TestRemapJournal", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		defer SetupWithWAL(t)()
+
+		in, _ := gongt.StrictInsert([]float64{0, 0, 0, 0, 0, 1})
+		gongt.StrictRemove(in)
+		write(t)
+		// the process crashes in the middle of replacing g, while g points to neither object
+		old, _ := Get().db.GetVal([]byte("g"))
+		in, err := Get().ngtInsert([]float64{1, 1, 1, 0, 0, 0})
+		if err != nil {
+			t.Fatalf("Unexpected error: TestRemapJournal(%v)", err)
+		}
+		it := &intent{Op: opReplace, ID: []byte("g"), Old: old, New: in}
+		Get().db.Delete([]byte("g"))
+		crash(t, nil)
+		defer SetupWithJournal(t, it)()
+
+		obj, err := GetObject([]byte("g"))
+		if err != nil || !reflect.DeepEqual(obj.Vector, []float32{1, 1, 1, 0, 0, 0}) {
+			t.Errorf("TestRemapJournal: %v, %v, wanted: the new vector", obj, err)
+		}
+		err = Fsck(context.Background(), 0, func(inc Inconsistency) error {
+			t.Errorf("TestRemapJournal: %+v, wanted: consistent", inc)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: TestRemapJournal(%v)", err)
+		}
+	})
+
+	t.Run("TestSavedBeforeTruncate", func(t *testing.T) {
+		defer SetupWithTeardown(t)()
+		defer SetupWithWAL(t)()
+
+		write(t)
+		// the index is saved, but the process crashes before the WAL is emptied
+		if err := gongt.SaveIndex(); err != nil {
+			t.Fatalf("Unexpected error: TestSavedBeforeTruncate(%v)", err)
+		}
+		crash(t, nil)
+		verify(t)
+	})
+}
@@ -0,0 +1,113 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"sync"
+)
+
+// walkPageSize is the number of IDs walk reads from the KVS at a time.
+var walkPageSize = DefaultListLimit
+
+func KNNGraph(ctx context.Context, k int, epsilon float32, f func(id []byte, neighbors []SearchResult) error) error {
+	return Get().KNNGraph(ctx, k, epsilon, f)
+}
+
+// KNNGraph calls f with the k nearest neighbors of every stored object, except the object itself.
+// f is called in the order the searches finish, which is not that of the KVS, and never concurrently.
+func (s *Service) KNNGraph(ctx context.Context, k int, epsilon float32, f func(id []byte, neighbors []SearchResult) error) error {
+	s, leave := s.enter()
+	defer leave()
+	return s.walk(ctx, func(id []byte) ([]SearchResult, error) {
+		return s.SearchByIDContext(ctx, id, k, epsilon, WithExcludeSelf(true))
+	}, f)
+}
+
+// walk runs search for every stored ID on searchWorkers goroutines and passes its result to emit one at a time.
+// IDs are read from the KVS a page at a time, so that memory stays bounded whatever the size of the index
+// and no transaction of the KVS is held while searching.
+// IDs removed after they are read are skipped. It stops at the first other error of search or emit,
+// or when ctx is canceled.
+func (s *Service) walk(ctx context.Context, search func(id []byte) ([]SearchResult, error), emit func(id []byte, res []SearchResult) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ids := make(chan []byte, searchWorkers)
+	var scanErr error
+	go func() {
+		defer close(ids)
+		db := s.dbContext(ctx)
+		var cursor []byte
+		for {
+			keys, next, err := db.Scan(cursor, nil, walkPageSize)
+			if err != nil {
+				scanErr = err
+				return
+			}
+			for _, key := range keys {
+				select {
+				case ids <- key:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if len(next) == 0 {
+				return
+			}
+			cursor = next
+		}
+	}()
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+	)
+	wg := &sync.WaitGroup{}
+	wg.Add(searchWorkers)
+	for w := 0; w < searchWorkers; w++ {
+		go func() {
+			defer wg.Done()
+			for id := range ids {
+				if ctx.Err() != nil {
+					continue
+				}
+				res, err := search(id)
+				if err == ErrIDNotFound {
+					continue
+				}
+				mu.Lock()
+				if err == nil && ctx.Err() == nil {
+					err = emit(id, res)
+				}
+				if err != nil && firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	switch {
+	case firstErr != nil:
+		return firstErr
+	case scanErr != nil:
+		return scanErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,112 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/yahoojapan/gongt"
+)
+
+func TestKNNGraph(t *testing.T) {
+	gongt.Get().SetObjectType(gongt.Float)
+	defer SetupWithMeta(t)()
+
+	t.Run("TestNeighbors", func(t *testing.T) {
+		got := make(map[string][]SearchResult)
+		err := KNNGraph(context.Background(), 2, gongt.DefaultEpsilon, func(id []byte, neighbors []SearchResult) error {
+			got[string(id)] = neighbors
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: TestNeighbors(%v)", err)
+		}
+		if len(got) != 16 {
+			t.Errorf("TestNeighbors: %v objects, wanted: 16", len(got))
+		}
+		for id, neighbors := range got {
+			if len(neighbors) != 2 {
+				t.Errorf("TestNeighbors(%v): %v, wanted: 2 neighbors", id, neighbors)
+			}
+			for _, n := range neighbors {
+				if string(n.Id) == id {
+					t.Errorf("TestNeighbors(%v): the object itself is in %v", id, neighbors)
+				}
+			}
+		}
+		if n := got["g5"]; len(n) != 2 || string(n[0].Id)+string(n[1].Id) != "g4g6" && string(n[0].Id)+string(n[1].Id) != "g6g4" {
+			t.Errorf("TestNeighbors(g5): %v, wanted: g4 and g6", n)
+		}
+	})
+
+	t.Run("TestPages", func(t *testing.T) {
+		defer func(n int) { walkPageSize = n }(walkPageSize)
+		walkPageSize = 3
+		got := make(map[string]bool)
+		err := KNNGraph(context.Background(), 2, gongt.DefaultEpsilon, func(id []byte, neighbors []SearchResult) error {
+			got[string(id)] = true
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: TestPages(%v)", err)
+		}
+		if len(got) != 16 {
+			t.Errorf("TestPages: %v objects, wanted: 16", len(got))
+		}
+	})
+
+	t.Run("TestError", func(t *testing.T) {
+		want := errors.New("failed")
+		n := 0
+		err := KNNGraph(context.Background(), 2, gongt.DefaultEpsilon, func(id []byte, neighbors []SearchResult) error {
+			n++
+			return want
+		})
+		if err != want || n != 1 {
+			t.Errorf("TestError: %v after %v calls, wanted: %v after 1 call", err, n, want)
+		}
+	})
+	t.Run("TestRemoved", func(t *testing.T) {
+		defer func(n int) { walkPageSize = n }(walkPageSize)
+		walkPageSize = 3
+		ids := []string{"a", "b", "c", "d", "e", "f"}
+		for i := 0; i < 10; i++ {
+			ids = append(ids, fmt.Sprintf("g%d", i))
+		}
+		got := make(map[string]bool)
+		err := KNNGraph(context.Background(), 2, gongt.DefaultEpsilon, func(id []byte, neighbors []SearchResult) error {
+			if len(got) == 0 {
+				// some of them are already read from the KVS but not searched yet
+				for _, r := range ids {
+					if r != string(id) {
+						if err := Remove([]byte(r)); err != nil {
+							t.Errorf("Unexpected error: TestRemoved(%v)", err)
+						}
+					}
+				}
+			}
+			got[string(id)] = true
+			return nil
+		})
+		if err != nil || len(got) != 1 {
+			t.Errorf("TestRemoved: %v objects, %v, wanted: 1 object, nil", len(got), err)
+		}
+	})
+}
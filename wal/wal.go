@@ -0,0 +1,339 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package wal provides an append-only log of checksummed records.
+package wal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// SyncPolicy decides when appended records are fsynced.
+type SyncPolicy int
+
+const (
+	// SyncAlways fsyncs every record before Append returns.
+	SyncAlways SyncPolicy = iota
+	// SyncInterval fsyncs the records appended in the last interval in the background,
+	// so a crash loses at most that much.
+	SyncInterval
+	// SyncNone leaves it to the OS. Only the records written before a crash of the process itself survive.
+	SyncNone
+)
+
+const (
+	// headerSize is the size of the length and the checksum before each record.
+	headerSize = 8
+	// MaxRecordSize is the largest record Append accepts.
+	MaxRecordSize = 1 << 30
+)
+
+var (
+	byteOrder = binary.LittleEndian
+	table     = crc32.MakeTable(crc32.Castagnoli)
+
+	ErrUnsupportedSyncPolicy = errors.New("unsupported sync policy")
+	ErrRecordTooLarge        = errors.New("record too large")
+	ErrClosed                = errors.New("log is closed")
+)
+
+// ParseSyncPolicy returns the policy named always, interval or none.
+func ParseSyncPolicy(s string) (SyncPolicy, error) {
+	switch s {
+	case "always":
+		return SyncAlways, nil
+	case "interval":
+		return SyncInterval, nil
+	case "none":
+		return SyncNone, nil
+	}
+	return 0, ErrUnsupportedSyncPolicy
+}
+
+func (p SyncPolicy) String() string {
+	switch p {
+	case SyncAlways:
+		return "always"
+	case SyncInterval:
+		return "interval"
+	case SyncNone:
+		return "none"
+	}
+	return fmt.Sprintf("SyncPolicy(%d)", int(p))
+}
+
+// Log is a file of records, each one framed by its length and its CRC-32C.
+// It is safe for concurrent use, and the records are kept in the order Append is called.
+type Log struct {
+	mu     sync.Mutex
+	f      *os.File
+	size   int64
+	torn   int64
+	policy SyncPolicy
+	// dirty is true if some records are not fsynced yet under SyncInterval.
+	dirty bool
+	stop  chan struct{}
+	done  chan struct{}
+	// err is the error of the last background fsync, returned by the next Append.
+	err error
+}
+
+// Open opens the log at path, creating it if it does not exist.
+// A torn or corrupted record and everything after it are cut off, since the process
+// crashed before acknowledging them. interval is only used by SyncInterval.
+func Open(path string, policy SyncPolicy, interval time.Duration) (*Log, error) {
+	switch policy {
+	case SyncAlways, SyncNone:
+	case SyncInterval:
+		if interval <= 0 {
+			return nil, fmt.Errorf("sync interval must be positive: %v", interval)
+		}
+	default:
+		return nil, ErrUnsupportedSyncPolicy
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	size, err := scan(f, func([]byte) error { return nil })
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	l := &Log{
+		f:      f,
+		size:   size,
+		torn:   info.Size() - size,
+		policy: policy,
+	}
+	if l.torn > 0 {
+		if err := l.truncate(size); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	if _, err := f.Seek(size, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if policy == SyncInterval {
+		l.stop = make(chan struct{})
+		l.done = make(chan struct{})
+		go l.syncEvery(interval)
+	}
+	return l, nil
+}
+
+// Read calls f for each intact record of the log at path without opening it for writing, so that nothing is
+// cut off, and returns the size of the torn or corrupted end. A log which does not exist has no records.
+func Read(path string, f func(rec []byte) error) (int64, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	size, err := scan(file, f)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size() - size, nil
+}
+
+// scan calls f for each record of r from the beginning, and returns the size of the intact records.
+func scan(r io.ReadSeeker, f func([]byte) error) (int64, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	br := bufio.NewReader(r)
+	var size int64
+	header := make([]byte, headerSize)
+	for {
+		if _, err := io.ReadFull(br, header); err != nil {
+			return size, nil
+		}
+		n := byteOrder.Uint32(header)
+		if n > MaxRecordSize {
+			return size, nil
+		}
+		rec := make([]byte, n)
+		if _, err := io.ReadFull(br, rec); err != nil {
+			return size, nil
+		}
+		if crc32.Checksum(rec, table) != byteOrder.Uint32(header[4:]) {
+			return size, nil
+		}
+		if err := f(rec); err != nil {
+			return size, err
+		}
+		size += headerSize + int64(n)
+	}
+}
+
+// Replay calls f for each record in the order they were appended, and stops at the first error of f.
+func (l *Log) Replay(f func(rec []byte) error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return ErrClosed
+	}
+	// the records Open kept are intact and nothing is appended while the lock is held
+	r := io.NewSectionReader(l.f, 0, l.size)
+	_, err := scan(r, f)
+	return err
+}
+
+// Append writes rec at the end of the log, and fsyncs it under SyncAlways.
+func (l *Log) Append(rec []byte) error {
+	if len(rec) > MaxRecordSize {
+		return ErrRecordTooLarge
+	}
+	b := make([]byte, headerSize+len(rec))
+	byteOrder.PutUint32(b, uint32(len(rec)))
+	byteOrder.PutUint32(b[4:], crc32.Checksum(rec, table))
+	copy(b[headerSize:], rec)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return ErrClosed
+	}
+	if err := l.err; err != nil {
+		l.err = nil
+		return err
+	}
+	if _, err := l.f.Write(b); err != nil {
+		// cut off the partial record so that the records after it are not lost on Open
+		l.truncate(l.size)
+		return err
+	}
+	l.size += int64(len(b))
+	switch l.policy {
+	case SyncAlways:
+		return l.f.Sync()
+	case SyncInterval:
+		l.dirty = true
+	}
+	return nil
+}
+
+// Truncate removes every record, typically after what they describe has been persisted elsewhere.
+func (l *Log) Truncate() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return ErrClosed
+	}
+	if err := l.truncate(0); err != nil {
+		return err
+	}
+	l.dirty = false
+	return nil
+}
+
+func (l *Log) truncate(size int64) error {
+	if err := l.f.Truncate(size); err != nil {
+		return err
+	}
+	if _, err := l.f.Seek(size, io.SeekStart); err != nil {
+		return err
+	}
+	l.size = size
+	return l.f.Sync()
+}
+
+// Sync fsyncs the records appended so far.
+func (l *Log) Sync() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return ErrClosed
+	}
+	l.dirty = false
+	return l.f.Sync()
+}
+
+func (l *Log) syncEvery(interval time.Duration) {
+	defer close(l.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+		}
+		l.mu.Lock()
+		if l.f != nil && l.dirty {
+			l.dirty = false
+			if err := l.f.Sync(); err != nil {
+				l.err = err
+			}
+		}
+		l.mu.Unlock()
+	}
+}
+
+// Size returns the size of the records in bytes.
+func (l *Log) Size() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.size
+}
+
+// Torn returns the size in bytes of the broken tail Open cut off.
+func (l *Log) Torn() int64 {
+	return l.torn
+}
+
+// Close fsyncs the records and closes the file.
+func (l *Log) Close() error {
+	if l.stop != nil {
+		close(l.stop)
+		<-l.done
+		l.stop = nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return nil
+	}
+	err := l.f.Sync()
+	if e := l.f.Close(); err == nil {
+		err = e
+	}
+	l.f = nil
+	return err
+}
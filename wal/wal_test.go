@@ -0,0 +1,175 @@
+//
+// Copyright (C) 2018 Yahoo Japan Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package wal
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func initLog(t *testing.T, policy SyncPolicy) (*Log, string, func()) {
+	dir, err := ioutil.TempDir("", "ngtd-wal")
+	if err != nil {
+		t.Fatalf("Unexpected error: initLog(%v)", err)
+	}
+	path := filepath.Join(dir, "wal")
+	l, err := Open(path, policy, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Unexpected error: initLog(%v)", err)
+	}
+	return l, path, func() {
+		l.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func records(t *testing.T, l *Log) []string {
+	var ret []string
+	if err := l.Replay(func(rec []byte) error {
+		ret = append(ret, string(rec))
+		return nil
+	}); err != nil {
+		t.Fatalf("Unexpected error: records(%v)", err)
+	}
+	return ret
+}
+
+func TestLog(t *testing.T) {
+	t.Run("TestAppend", func(t *testing.T) {
+		for _, policy := range []SyncPolicy{SyncAlways, SyncInterval, SyncNone} {
+			l, path, teardown := initLog(t, policy)
+			for _, rec := range []string{"a", "", "ccc"} {
+				if err := l.Append([]byte(rec)); err != nil {
+					t.Fatalf("Unexpected error: TestAppend(%v)", err)
+				}
+			}
+			if got, want := records(t, l), []string{"a", "", "ccc"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("TestAppend(%v): %q, wanted: %q", policy, got, want)
+			}
+			if got, want := l.Size(), int64(3*headerSize+4); got != want {
+				t.Errorf("TestAppend(%v): size %d, wanted: %d", policy, got, want)
+			}
+
+			l.Close()
+			l, err := Open(path, policy, time.Millisecond)
+			if err != nil {
+				t.Fatalf("Unexpected error: TestAppend(%v)", err)
+			}
+			if err := l.Append([]byte("d")); err != nil {
+				t.Fatalf("Unexpected error: TestAppend(%v)", err)
+			}
+			if got, want := records(t, l), []string{"a", "", "ccc", "d"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("TestAppend(%v): %q after reopen, wanted: %q", policy, got, want)
+			}
+			l.Close()
+			teardown()
+		}
+	})
+
+	t.Run("TestTruncate", func(t *testing.T) {
+		l, _, teardown := initLog(t, SyncAlways)
+		defer teardown()
+		l.Append([]byte("a"))
+		if err := l.Truncate(); err != nil {
+			t.Fatalf("Unexpected error: TestTruncate(%v)", err)
+		}
+		l.Append([]byte("b"))
+		if got, want := records(t, l), []string{"b"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("TestTruncate: %q, wanted: %q", got, want)
+		}
+	})
+
+	t.Run("TestTorn", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			corrupt func(b []byte) []byte
+			want    []string
+		}{
+			{"partial header", func(b []byte) []byte { return append(b, 1, 0, 0) }, []string{"a", "bb"}},
+			{"partial record", func(b []byte) []byte { return b[:len(b)-1] }, []string{"a"}},
+			{"checksum mismatch", func(b []byte) []byte { b[len(b)-1] ^= 0xff; return b }, []string{"a"}},
+		}
+		for _, tt := range tests {
+			l, path, teardown := initLog(t, SyncAlways)
+			l.Append([]byte("a"))
+			l.Append([]byte("bb"))
+			l.Close()
+
+			b, _ := ioutil.ReadFile(path)
+			b = tt.corrupt(b)
+			ioutil.WriteFile(path, b, 0644)
+
+			l, err := Open(path, SyncAlways, 0)
+			if err != nil {
+				t.Fatalf("Unexpected error: TestTorn(%v)", err)
+			}
+			if got := records(t, l); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TestTorn(%v): %q, wanted: %q", tt.name, got, tt.want)
+			}
+			if l.Torn() == 0 {
+				t.Errorf("TestTorn(%v): nothing is cut off", tt.name)
+			}
+			l.Append([]byte("c"))
+			if got, want := records(t, l), append(tt.want, "c"); !reflect.DeepEqual(got, want) {
+				t.Errorf("TestTorn(%v): %q after append, wanted: %q", tt.name, got, want)
+			}
+			l.Close()
+			teardown()
+		}
+	})
+
+	t.Run("TestRead", func(t *testing.T) {
+		l, path, teardown := initLog(t, SyncAlways)
+		defer teardown()
+		l.Append([]byte("a"))
+		l.Append([]byte("bb"))
+		l.Close()
+		b, _ := ioutil.ReadFile(path)
+		ioutil.WriteFile(path, b[:len(b)-1], 0644)
+
+		var got []string
+		torn, err := Read(path, func(rec []byte) error {
+			got = append(got, string(rec))
+			return nil
+		})
+		if err != nil || torn == 0 || !reflect.DeepEqual(got, []string{"a"}) {
+			t.Errorf("TestRead: %q, torn %d, %v, wanted: [\"a\"], torn > 0", got, torn, err)
+		}
+		// the torn end is left as it is
+		if after, _ := ioutil.ReadFile(path); len(after) != len(b)-1 {
+			t.Errorf("TestRead: %d bytes after read, wanted: %d", len(after), len(b)-1)
+		}
+		if torn, err := Read(path+".missing", nil); torn != 0 || err != nil {
+			t.Errorf("TestRead(missing): torn %d, %v, wanted: 0, nil", torn, err)
+		}
+	})
+
+	t.Run("TestParseSyncPolicy", func(t *testing.T) {
+		for _, policy := range []SyncPolicy{SyncAlways, SyncInterval, SyncNone} {
+			if got, err := ParseSyncPolicy(policy.String()); err != nil || got != policy {
+				t.Errorf("TestParseSyncPolicy(%v): %v, %v", policy, got, err)
+			}
+		}
+		if _, err := ParseSyncPolicy("sometimes"); err != ErrUnsupportedSyncPolicy {
+			t.Errorf("TestParseSyncPolicy: %v, wanted: %v", err, ErrUnsupportedSyncPolicy)
+		}
+	})
+}